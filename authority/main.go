@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+
+	"dec/internal/auth"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var flagKey = flag.String("key", "authority.key", "Private key of the authority, keep it off the machines running clients")
+var flagPublic = flag.String("public", "authority.pub", "Public key of the authority, handed to the elevators with --auth-key-file")
+var flagInit = flag.Bool("init", false, "Generate the key pair of the authority instead of issuing credentials")
+var flagPrincipal = flag.String("principal", "", "Name the credentials are issued to")
+var flagRole = flag.String("role", "passenger", "Role of the principal: passenger, operator or admin")
+var flagTTL = flag.Duration("ttl", auth.TOKEN_TTL, "Time the credentials are honoured for")
+var flagOut = flag.String("out", "", "File the credentials are written to, readable by its owner only")
+
+func main() {
+	flag.Parse()
+
+	if *flagInit {
+		if err := auth.GenerateAuthority(*flagKey, *flagPublic); err != nil {
+			log.WithError(err).Fatal("failed to generate the authority")
+		}
+		log.WithField("public", *flagPublic).Info("authority generated")
+		return
+	}
+
+	if *flagPrincipal == "" || *flagOut == "" {
+		log.Fatal("--principal and --out are required")
+	}
+	role, err := auth.ParseRole(*flagRole)
+	if err != nil {
+		log.WithError(err).Fatal("invalid role")
+	}
+	authority, err := auth.LoadAuthority(*flagKey)
+	if err != nil {
+		log.WithError(err).Fatal("failed to load the authority")
+	}
+
+	credentials, err := auth.NewCredentials(authority, *flagPrincipal, role, *flagTTL)
+	if err != nil {
+		log.WithError(err).Fatal("failed to issue credentials")
+	}
+	if err := credentials.Save(*flagOut); err != nil {
+		log.WithError(err).Fatal("failed to write credentials")
+	}
+	log.WithFields(log.Fields{
+		"principal": *flagPrincipal,
+		"role":      role,
+		"ttl":       *flagTTL,
+	}).Info("credentials issued")
+}
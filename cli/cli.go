@@ -1,23 +1,48 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
-	"log"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	. "dec/client"
+	"dec/client/api"
+	"dec/group"
+	"dec/internal/auth"
+	"dec/internal/logging"
+	"dec/internal/mtls"
+	"dec/internal/tracing"
+	"dec/messages"
 
 	"github.com/chzyer/readline"
 	proto "github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 var flagBind = flag.String("bind", "127.0.0.1:8999", "Bind to address")
+var flagCert = flag.String("cert", "", "TLS certificate presented to peers")
+var flagKey = flag.String("key", "", "TLS key of the certificate")
+var flagCA = flag.String("ca", "", "CA the certificates of peers must be issued by")
+var flagCredentials = flag.String("credentials", "", "Credentials issued by the authority signing the commands of this client")
 var flagElevators = flag.Int("elevators", 16, "Amount of elevators to connect to")
-var client *Client
+var flagHosts = Hosts{}
+var flagHTTP = flag.String("http", "", "Also serve the HTTP API on address")
+var flagDestinationDispatch = flag.Bool("destination-dispatch", false, "Group passengers going to the same floor into the same car")
+var flagPickupRate = flag.Float64("pickup-rate", 0, "Pickups admitted per floor and second, unlimited when 0")
+var flagPickupBurst = flag.Int("pickup-burst", 5, "Pickups admitted at once per floor on top of --pickup-rate")
+var flagEmbedded = flag.Bool("embedded", false, "Run the elevators inside this process instead of connecting to services")
+var flagDispatcher = flag.String("dispatcher", "", "Connect to the dispatcher service on address instead of the elevators, separate the addresses of replicas with commas")
+var flagLogLevel = flag.String("log-level", "info", "Log entries from this level up: debug, info, warn or error")
+var flagLogFormat = flag.String("log-format", "text", "Log as text or json")
+var flagTrace = flag.String("trace", "", "Export spans to stdout or append them to this file, off when empty")
+var flagTimeout = flag.Duration("timeout", 30*time.Second, "Time a command may take before it is given up")
+var elevators group.Group
 
 // Reference imports to suppress errors if they are not otherwise used
 var _ = proto.Marshal
@@ -29,6 +54,9 @@ var completer = readline.NewPrefixCompleter(
 	readline.PcItem("status"),
 	readline.PcItem("update"),
 	readline.PcItem("pickup"),
+	readline.PcItem("destination"),
+	readline.PcItem("cancel"),
+	readline.PcItem("batch"),
 	readline.PcItem("step"),
 	readline.PcItem("help"),
 	readline.PcItem("exit"),
@@ -44,7 +72,11 @@ func filterInput(r rune) (rune, bool) {
 }
 
 func main() {
+	flag.Var(flagHosts, "host", "Address of a service hosting several elevators as address=ids, e.g. 127.0.0.1:9000=0-7, may be repeated")
 	flag.Parse()
+	if err := logging.Setup(*flagLogLevel, *flagLogFormat); err != nil {
+		log.WithError(err).Fatal("logging setup failed")
+	}
 
 	logo := `
         __
@@ -53,11 +85,28 @@ func main() {
  / /_/ /  __/ /__
  \__,_/\___/\___/
 `
-	log.Println(logo)
+	fmt.Println(logo)
 
 	// setup
-	client = NewClient(*flagBind, *flagElevators)
-	client.SendStatusRequest(StatusRequestOpt{BroadcastAll: true})
+	stopTracing, err := tracing.Setup("cli", *flagTrace)
+	if err != nil {
+		log.WithError(err).Fatal("tracing setup failed")
+	}
+	defer stopTracing()
+
+	if *flagDispatcher != "" && *flagHTTP != "" {
+		log.Fatal("--http serves the elevators of this process, use the --http of the dispatcher with --dispatcher")
+	}
+	if *flagDispatcher != "" {
+		connectDispatcher()
+	} else {
+		startClient()
+	}
+	defer elevators.Close()
+	run(func(ctx context.Context) error {
+		_, err := elevators.Status(ctx)
+		return err
+	})
 
 	l, err := readline.NewEx(&readline.Config{
 		Prompt:          "\033[31m»\033[0m ",
@@ -71,7 +120,7 @@ func main() {
 	})
 
 	if err != nil {
-		panic(err)
+		log.WithError(err).Fatal("terminal setup failed")
 	}
 	defer l.Close()
 
@@ -92,57 +141,149 @@ func main() {
 
 		switch {
 		case line == "status":
-			statusRequestOpt := StatusRequestOpt{BroadcastAll: true}
-
-			client.SendStatusRequest(statusRequestOpt)
-			client.PrintCurrentStatus()
+			if run(func(ctx context.Context) error {
+				_, err := elevators.Status(ctx)
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
 		case strings.HasPrefix(line, "update "):
 			parts := strings.SplitN(line, " ", 4)
 
 			if len(parts) != 4 {
 				fmt.Printf("Wrong number of arguments for `update`. expected: ID Goal Direction\n")
-			} else {
-				i, err := strconv.Atoi(parts[1])
-				if err != nil {
-					panic(err)
-				}
-				id := int(i)
+				break
+			}
+			args, err := parseInts(parts[1:])
+			if err != nil {
+				log.Warn(err)
+				break
+			}
 
-				g, err := strconv.Atoi(parts[2])
-				if err != nil {
-					panic(err)
+			if run(func(ctx context.Context) error {
+				_, err := elevators.Update(ctx, args[0], uint32(args[1]), int32(args[2]))
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
+		case strings.HasPrefix(line, "pickup "):
+			parts := strings.SplitN(line, " ", 3)
+
+			if len(parts) != 3 {
+				fmt.Printf("Wrong number of arguments for `pickup`. expected: Floor Direction\n")
+				break
+			}
+			args, err := parseInts(parts[1:])
+			if err != nil {
+				log.Warn(err)
+				break
+			}
+
+			if run(func(ctx context.Context) error {
+				a, err := elevators.Pickup(ctx, uint32(args[0]), int32(args[1]))
+				if err == nil && a.Queued {
+					log.WithFields(log.Fields{
+						logging.FIELD_FLOOR:     args[0],
+						logging.FIELD_DIRECTION: args[1],
+					}).Info("all cars are busy, pickup queued")
 				}
-				goal := uint32(g)
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
+		case strings.HasPrefix(line, "destination "):
+			parts := strings.SplitN(line, " ", 3)
 
-				d, err := strconv.Atoi(parts[3])
-				if err != nil {
-					panic(err)
+			if len(parts) != 3 {
+				fmt.Printf("Wrong number of arguments for `destination`. expected: Floor Destination\n")
+				break
+			}
+			args, err := parseInts(parts[1:])
+			if err != nil {
+				log.Warn(err)
+				break
+			}
+
+			if run(func(ctx context.Context) error {
+				a, err := elevators.DestinationPickup(ctx, uint32(args[0]), uint32(args[1]))
+				if err == nil && a.Queued {
+					log.WithFields(log.Fields{
+						logging.FIELD_FLOOR: args[0],
+						"destination":       args[1],
+					}).Info("all cars are busy, pickup queued")
 				}
-				direction := int32(d)
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
+		case strings.HasPrefix(line, "cancel "):
+			parts := strings.SplitN(line, " ", 3)
 
-				client.SendUpdateRequest(id, goal, direction)
-				client.PrintCurrentStatus()
+			if len(parts) != 3 {
+				fmt.Printf("Wrong number of arguments for `cancel`. expected: ID Floor\n")
+				break
 			}
-		case strings.HasPrefix(line, "pickup "):
+			args, err := parseInts(parts[1:])
+			if err != nil {
+				log.Warn(err)
+				break
+			}
+
+			if run(func(ctx context.Context) error {
+				_, err := elevators.Cancel(ctx, args[0], uint32(args[1]))
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
+		case strings.HasPrefix(line, "batch "):
 			parts := strings.SplitN(line, " ", 3)
 
 			if len(parts) != 3 {
-				fmt.Printf("Wrong number of arguments for `pickup`. expected: Floor Direction\n")
-			} else {
-				f, err := strconv.Atoi(parts[1])
-				if err != nil {
-					panic(err)
+				fmt.Printf("Wrong number of arguments for `batch`. expected: ID Command, ...\n")
+				break
+			}
+			id, err := strconv.Atoi(parts[1])
+			if err != nil {
+				log.Warn("Invalid number : ", strconv.Quote(parts[1]))
+				break
+			}
+
+			items := []interface{}{}
+			for _, command := range strings.Split(parts[2], ",") {
+				args := strings.Fields(command)
+				if len(args) != 3 {
+					fmt.Printf("Wrong number of arguments for `%s`. expected: Floor Direction\n", strings.TrimSpace(command))
+					items = nil
+					break
 				}
-				floor := uint32(f)
 
-				d, err := strconv.Atoi(parts[2])
+				values, err := parseInts(args[1:])
 				if err != nil {
-					panic(err)
+					log.Warn(err)
+					items = nil
+					break
 				}
-				direction := int32(d)
+				floor, direction := uint32(values[0]), int32(values[1])
 
-				client.SendPickupRequest(floor, direction)
-				client.PrintCurrentStatus()
+				switch args[0] {
+				case "update":
+					items = append(items, UpdateRequestItem{Goal: floor, State: direction})
+				case "pickup":
+					items = append(items, PickupRequestItem{Floor: floor, State: direction})
+				default:
+					log.Warn("Invalid batch command : ", strconv.Quote(args[0]))
+					items = nil
+				}
+				if items == nil {
+					break
+				}
+			}
+
+			if items != nil && run(func(ctx context.Context) error {
+				_, err := elevators.Batch(ctx, id, items)
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
 			}
 		case line == "help":
 			helpText := `
@@ -150,20 +291,136 @@ func main() {
   - status
   - update [id] [goal] [direction]
   - pickup [floor] [direction]
-  - step
+  - destination [floor] [destination]
+  - cancel [id] [floor]
+  - batch [id] [update|pickup] [floor] [direction], ...
+  - step [count|idle]
   - help
   - exit
 `
 			fmt.Println(helpText)
 		case line == "step":
-			client.SendStepRequest()
-			client.PrintCurrentStatus()
+			if run(func(ctx context.Context) error {
+				_, err := elevators.Step(ctx)
+				return err
+			}) {
+				PrintStatus(elevators.CurrentStatus())
+			}
+		case strings.HasPrefix(line, "step "):
+			parts := strings.SplitN(line, " ", 2)
+
+			var count uint32
+			untilIdle := parts[1] == "idle"
+			if !untilIdle {
+				args, err := parseInts(parts[1:])
+				if err != nil {
+					log.Warn(err)
+					break
+				}
+				count = uint32(args[0])
+			}
+
+			var events []*messages.ArrivalEvent
+			if run(func(ctx context.Context) (err error) {
+				events, err = elevators.Steps(ctx, count, untilIdle)
+				return err
+			}) {
+				for _, event := range events {
+					logging.Elevator(event.Id).WithField(logging.FIELD_FLOOR, event.Floor).Info("stopped")
+				}
+				PrintStatus(elevators.CurrentStatus())
+			}
 		case line == "exit":
 			goto exit
 		case line == "":
 		default:
-			log.Println("Invalid command :", strconv.Quote(line))
+			log.Warn("Invalid command : ", strconv.Quote(line))
 		}
 	}
 exit:
 }
+
+// run gives fn --timeout to finish and reports whether it succeeded
+func run(fn func(ctx context.Context) error) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), *flagTimeout)
+	defer cancel()
+
+	if err := fn(ctx); err != nil {
+		log.Error(err)
+		return false
+	}
+
+	return true
+}
+
+// parseInts reads the numeric arguments of a command
+func parseInts(args []string) ([]int, error) {
+	values := make([]int, len(args))
+	for i, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid number : %s", strconv.Quote(arg))
+		}
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+// startClient talks to the elevators from this process
+func startClient() {
+	options, err := mtls.RemotingOptions(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+
+	credentials, err := auth.LoadCredentials(*flagCredentials)
+	if err != nil {
+		log.WithError(err).Fatal("auth setup failed")
+	}
+
+	var c *Client
+	if *flagEmbedded {
+		c, err = group.NewEmbeddedClient(*flagElevators, nil)
+		if err != nil {
+			log.WithError(err).Fatal("failed to embed the elevators")
+		}
+	} else {
+		c = NewClient(*flagBind, *flagElevators, options...)
+		if err := c.Locate(flagHosts); err != nil {
+			log.WithError(err).Fatal("invalid hosts")
+		}
+	}
+	c.Credentials = credentials
+	c.DestinationDispatch = *flagDestinationDispatch
+	c.Limiter = NewLimiter(*flagPickupRate, *flagPickupBurst)
+	if !run(c.Hello) {
+		log.Fatal("handshake failed")
+	}
+
+	if *flagHTTP != "" {
+		go func() {
+			log.WithError(api.ListenAndServe(*flagHTTP, c)).Error("http api stopped")
+		}()
+	}
+
+	elevators = group.Local(c)
+}
+
+// connectDispatcher leaves the elevators and the dispatch state to the
+// dispatcher service, several terminals may share it
+func connectDispatcher() {
+	creds, err := mtls.Credentials(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+
+	dial := grpc.WithInsecure()
+	if creds != nil {
+		dial = grpc.WithTransportCredentials(creds)
+	}
+	elevators, err = group.Dial(strings.Split(*flagDispatcher, ","), dial)
+	if err != nil {
+		log.WithError(err).Fatal("failed to connect to dispatcher")
+	}
+}
@@ -0,0 +1,347 @@
+// Package api serves a client over HTTP with JSON bodies, so integrations
+// that do not speak protoactor can drive the elevators.
+// The routes are described in openapi.yaml next to this file.
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"dec/client"
+	"dec/internal/auth"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Time a request may take, the client is locked for the other requests
+// meanwhile
+const REQUEST_TIMEOUT = 30 * time.Second
+
+type PickupRequest struct {
+	Floor     uint32 `json:"floor"`
+	Direction int32  `json:"direction"`
+}
+
+type UpdateRequest struct {
+	Id        int    `json:"id"`
+	Goal      uint32 `json:"goal"`
+	Direction int32  `json:"direction"`
+}
+
+type StepRequest struct {
+	Count     uint32 `json:"count"`
+	UntilIdle bool   `json:"until_idle"`
+}
+
+type CancelRequest struct {
+	Id    int    `json:"id"`
+	Floor uint32 `json:"floor"`
+}
+
+type Arrival struct {
+	Id    uint32 `json:"id"`
+	Floor uint32 `json:"floor"`
+	State int32  `json:"state"`
+}
+
+type StatusResponse struct {
+	Elevators []*client.ElevatorStatus `json:"elevators"`
+	Arrivals  []*Arrival               `json:"arrivals,omitempty"`
+	Queued    bool                     `json:"queued,omitempty"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Guard runs fn holding Client.Mu once the caller of ctx may run
+// actions. The dispatcher guards with its gRPC service, so both transports
+// are authorized and replicated alike.
+type Guard func(ctx context.Context, fn func(ctx context.Context) error, actions ...string) error
+
+// Server maps the HTTP routes onto the methods of a shared client
+type Server struct {
+	Client *client.Client
+	Mux    *http.ServeMux
+	// Guard is nil when every caller may run anything
+	Guard Guard
+}
+
+// handlerFunc returns the status code and the body to encode, ctx ends
+// with the request or after REQUEST_TIMEOUT
+type handlerFunc func(ctx context.Context, r *http.Request) (int, interface{})
+
+func NewServer(c *client.Client) *Server {
+	s := &Server{
+		Client: c,
+		Mux:    http.NewServeMux(),
+	}
+	s.Mux.Handle("/status", s.handle("GET", s.status))
+	s.Mux.Handle("/pickup", s.handle("POST", s.pickup))
+	s.Mux.Handle("/update", s.handle("POST", s.update))
+	s.Mux.Handle("/step", s.handle("POST", s.step))
+	s.Mux.Handle("/cancel", s.handle("POST", s.cancel))
+	s.Mux.HandleFunc("/events", s.events)
+	s.Mux.Handle("/metrics", promhttp.Handler())
+
+	return s
+}
+
+func ListenAndServe(bind string, c *client.Client) error {
+	return Serve(bind, NewServer(c), nil)
+}
+
+// Serve serves the routes of s on bind, over TLS when config is set
+func Serve(bind string, s *Server, config *tls.Config) error {
+	log.WithField("address", bind).Info("http api listening")
+
+	if config == nil {
+		return http.ListenAndServe(bind, s)
+	}
+	server := &http.Server{Addr: bind, Handler: s, TLSConfig: config}
+
+	return server.ListenAndServeTLS("", "")
+}
+
+// ServeHTTP hands the TLS state of r to the guard the way gRPC does, so
+// callers are identified by their certificate on both transports
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.TLS != nil {
+		r = r.WithContext(peer.NewContext(r.Context(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: *r.TLS},
+		}))
+	}
+	s.Mux.ServeHTTP(w, r)
+}
+
+// handle restricts a route to method
+func (s *Server) handle(method string, fn handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeJSON(w, http.StatusMethodNotAllowed, &ErrorResponse{
+				Error: fmt.Sprintf("method %s not allowed", r.Method),
+			})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), REQUEST_TIMEOUT)
+		defer cancel()
+
+		code, body := fn(ctx, r)
+		writeJSON(w, code, body)
+	})
+}
+
+// run runs fn through the guard, access to the client is serialized
+// either way
+func (s *Server) run(ctx context.Context, fn func(ctx context.Context) error, actions ...string) error {
+	if s.Guard != nil {
+		return s.Guard(ctx, fn, actions...)
+	}
+
+	s.Client.Mu.Lock()
+	defer s.Client.Mu.Unlock()
+
+	return fn(ctx)
+}
+
+// rpcCodes are the status codes of the errors a guard returns
+var rpcCodes = map[codes.Code]int{
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.Unavailable:      http.StatusServiceUnavailable,
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithError(err).Error("failed to encode response")
+	}
+}
+
+func badRequest(format string, a ...interface{}) (int, interface{}) {
+	return http.StatusBadRequest, &ErrorResponse{Error: fmt.Sprintf(format, a...)}
+}
+
+// failed maps an error of the client onto a status code
+func failed(err error) (int, interface{}) {
+	code := http.StatusInternalServerError
+	switch err.(type) {
+	case *client.ValidationError:
+		code = http.StatusBadRequest
+	case *client.RefusedError:
+		code = http.StatusConflict
+	case *client.RateLimitedError:
+		code = http.StatusTooManyRequests
+	}
+	if err == context.DeadlineExceeded || err == context.Canceled {
+		code = http.StatusGatewayTimeout
+	}
+	if st, ok := status.FromError(err); ok {
+		if c, ok := rpcCodes[st.Code()]; ok {
+			code = c
+		}
+		err = errors.New(st.Message())
+	}
+
+	return code, &ErrorResponse{Error: err.Error()}
+}
+
+func decode(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
+
+func (s *Server) newStatusResponse() *StatusResponse {
+	return &StatusResponse{Elevators: s.Client.CurrentStatus()}
+}
+
+// command runs fn and answers with the statuses it left behind
+func (s *Server) command(ctx context.Context, fn func(ctx context.Context) error, actions ...string) (int, interface{}) {
+	var res *StatusResponse
+	err := s.run(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		res = s.newStatusResponse()
+		return nil
+	}, actions...)
+	if err != nil {
+		return failed(err)
+	}
+
+	return http.StatusOK, res
+}
+
+func (s *Server) status(ctx context.Context, r *http.Request) (int, interface{}) {
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Status(ctx)
+		return err
+	}, auth.ACTION_STATUS)
+}
+
+func (s *Server) pickup(ctx context.Context, r *http.Request) (int, interface{}) {
+	var req PickupRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("invalid body: %s", err)
+	}
+	if err := s.Client.ValidateFloor(req.Floor); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := s.Client.ValidateDirection(req.Direction); err != nil {
+		return badRequest("%s", err)
+	}
+
+	var res *StatusResponse
+	err := s.run(ctx, func(ctx context.Context) error {
+		a, err := s.Client.Pickup(ctx, req.Floor, req.Direction)
+		if err != nil {
+			return err
+		}
+		res = s.newStatusResponse()
+		res.Queued = a.Queued
+		return nil
+	}, auth.ACTION_PICKUP)
+	if err != nil {
+		return failed(err)
+	}
+
+	// No car was free, the pickup runs after a later step
+	if res.Queued {
+		return http.StatusAccepted, res
+	}
+
+	return http.StatusOK, res
+}
+
+func (s *Server) update(ctx context.Context, r *http.Request) (int, interface{}) {
+	var req UpdateRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("invalid body: %s", err)
+	}
+	if err := s.Client.ValidateId(req.Id); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := s.Client.ValidateFloor(req.Goal); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := s.Client.ValidateDirection(req.Direction); err != nil {
+		return badRequest("%s", err)
+	}
+
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Update(ctx, req.Id, req.Goal, req.Direction)
+		return err
+	}, auth.ACTION_UPDATE)
+}
+
+func (s *Server) step(ctx context.Context, r *http.Request) (int, interface{}) {
+	var req StepRequest
+	// An empty body is a single step
+	if r.ContentLength != 0 {
+		if err := decode(r, &req); err != nil {
+			return badRequest("invalid body: %s", err)
+		}
+	}
+
+	if req.Count <= 1 && !req.UntilIdle {
+		return s.command(ctx, func(ctx context.Context) error {
+			_, err := s.Client.Step(ctx)
+			return err
+		}, auth.ACTION_STEP)
+	}
+
+	var res *StatusResponse
+	err := s.run(ctx, func(ctx context.Context) error {
+		events, err := s.Client.Steps(ctx, req.Count, req.UntilIdle)
+		if err != nil {
+			return err
+		}
+		res = s.newStatusResponse()
+		for _, event := range events {
+			res.Arrivals = append(res.Arrivals, &Arrival{
+				Id:    event.Id,
+				Floor: event.Floor,
+				State: event.State,
+			})
+		}
+		return nil
+	}, auth.ACTION_STEP)
+	if err != nil {
+		return failed(err)
+	}
+
+	return http.StatusOK, res
+}
+
+func (s *Server) cancel(ctx context.Context, r *http.Request) (int, interface{}) {
+	var req CancelRequest
+	if err := decode(r, &req); err != nil {
+		return badRequest("invalid body: %s", err)
+	}
+	if err := s.Client.ValidateId(req.Id); err != nil {
+		return badRequest("%s", err)
+	}
+	if err := s.Client.ValidateFloor(req.Floor); err != nil {
+		return badRequest("%s", err)
+	}
+
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Cancel(ctx, req.Id, req.Floor)
+		return err
+	}, auth.ACTION_CANCEL)
+}
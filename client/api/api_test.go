@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"dec/client"
+	"dec/internal/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestServer() *Server {
+	return NewServer(&client.Client{
+		Mu:            &sync.Mutex{},
+		Pending:       client.NewPending(),
+		ElevatorCount: 2,
+	})
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	s := newTestServer()
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("GET", "/pickup", nil))
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Error("Expected 405, got ", w.Code)
+	}
+	if allow := w.Header().Get("Allow"); allow != "POST" {
+		t.Error("Expected POST, got ", allow)
+	}
+}
+
+func TestValidation(t *testing.T) {
+	s := newTestServer()
+	bodies := map[string]string{
+		"/pickup": `{"floor": 16, "direction": 1}`,
+		"/update": `{"id": 2, "goal": 3, "direction": 1}`,
+		"/cancel": `{"id": 0, "floor": 3, "extra": true}`,
+		"/step":   `{"count": "ten"}`,
+	}
+
+	for path, body := range bodies {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest("POST", path, strings.NewReader(body)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Error("Expected 400 for ", path, ", got ", w.Code)
+		}
+		if !strings.Contains(w.Body.String(), `"error"`) {
+			t.Error("Expected an error body for ", path, ", got ", w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("POST", "/pickup", strings.NewReader(`{"floor": 3, "direction": 0}`)))
+	if !strings.Contains(w.Body.String(), "direction must be 1 or -1") {
+		t.Error("Expected a direction error, got ", w.Body.String())
+	}
+}
+
+func TestFailed(t *testing.T) {
+	errors := map[error]int{
+		&client.ValidationError{Reason: "floor 16 is out of range"}: http.StatusBadRequest,
+		&client.RefusedError{Id: 1, Reason: "draining"}:             http.StatusConflict,
+		&client.RateLimitedError{Floor: 3}:                          http.StatusTooManyRequests,
+		context.DeadlineExceeded:                                    http.StatusGatewayTimeout,
+		status.Error(codes.PermissionDenied, "denied"):              http.StatusForbidden,
+		status.Error(codes.Unavailable, "not the leader"):           http.StatusServiceUnavailable,
+	}
+
+	for err, expected := range errors {
+		if code, _ := failed(err); code != expected {
+			t.Error("Expected ", expected, " for ", err, ", got ", code)
+		}
+	}
+}
+
+func TestGuard(t *testing.T) {
+	s := newTestServer()
+	ran := []string{}
+	s.Guard = func(ctx context.Context, fn func(ctx context.Context) error, actions ...string) error {
+		ran = append(ran, actions...)
+		return status.Error(codes.PermissionDenied, "passenger may not step")
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("POST", "/step", strings.NewReader(`{}`)))
+	if w.Code != http.StatusForbidden {
+		t.Error("Expected 403, got ", w.Code)
+	}
+	if len(ran) != 1 || ran[0] != auth.ACTION_STEP {
+		t.Error("Expected the step to be guarded, got ", ran)
+	}
+
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("GET", "/events", nil))
+	if w.Code != http.StatusForbidden {
+		t.Error("Expected the feed to be guarded, got ", w.Code)
+	}
+}
+
+func TestFeedFilter(t *testing.T) {
+	f, err := newFeedFilter(httptest.NewRequest("GET", "/events?id=1&type=status&type=queued", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !f.Match(&client.Event{Type: client.EVENT_STATUS, Id: 1}) {
+		t.Error("Expected status of elevator 1 to match")
+	}
+	if f.Match(&client.Event{Type: client.EVENT_STATUS, Id: 2}) {
+		t.Error("Expected status of elevator 2 to be filtered")
+	}
+	if f.Match(&client.Event{Type: client.EVENT_ASSIGNED, Id: 1}) {
+		t.Error("Expected assignments to be filtered")
+	}
+	// queued pickups have no elevator to match on
+	if f.Match(&client.Event{Type: client.EVENT_QUEUED}) {
+		t.Error("Expected queued pickups to be filtered")
+	}
+
+	if _, err := newFeedFilter(httptest.NewRequest("GET", "/events?id=one", nil)); err == nil {
+		t.Error("Expected an error, got none")
+	}
+}
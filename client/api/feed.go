@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"dec/client"
+	"dec/internal/auth"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+// Events buffered per connection before the feed starts dropping
+const FEED_BUFFER = 256
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+func newFeedFilter(r *http.Request) (*client.EventFilter, error) {
+	query := r.URL.Query()
+
+	ids := []uint32{}
+	for _, v := range query["id"] {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uint32(id))
+	}
+
+	return client.NewEventFilter(ids, query["type"]), nil
+}
+
+// events streams the client feed over a WebSocket as JSON
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	filter, err := newFeedFilter(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &ErrorResponse{Error: "invalid id: " + err.Error()})
+		return
+	}
+	// The feed is read without the client, the guard only checks the caller
+	if err := s.run(r.Context(), func(context.Context) error { return nil }, auth.ACTION_STATUS); err != nil {
+		code, body := failed(err)
+		writeJSON(w, code, body)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.WithError(err).Warn("websocket upgrade failed")
+		return
+	}
+	defer conn.Close()
+
+	ch := s.Client.Feed.Subscribe(FEED_BUFFER)
+	defer s.Client.Feed.Unsubscribe(ch)
+
+	// The feed is one way, reading only notices the peer going away
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case e := <-ch:
+			if !filter.Match(e) {
+				continue
+			}
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
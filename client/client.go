@@ -1,43 +1,80 @@
 package client
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"math"
 	"os"
+	"reflect"
 	"strconv"
 	"sync"
+	"time"
 
+	"dec/internal/auth"
+	"dec/internal/logging"
 	"dec/internal/queue"
+	"dec/internal/tracing"
 	"dec/messages"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/mailbox"
 	"github.com/AsynkronIT/protoactor-go/remote"
 	"github.com/olekukonko/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const NOT_FOUND uint32 = math.MaxUint32
 
+// Messages the mailbox of the client actor holds, as many as the
+// elevators hold
+const MAILBOX_SIZE = 10000
+
+// Time SendHelloRequest gives every elevator to answer the handshake, and
+// SendStatusRequest to answer with its status
+const HELLO_TIMEOUT = 10 * time.Second
+
+// Client is not safe for concurrent use, callers sharing one must hold Mu
 type Client struct {
-	Wg                *sync.WaitGroup
-	ElevatorCount     int
-	ElevatorPidList   *[]*actor.PID
-	ElevatorStatusMap *sync.Map
-	ClientActor       *ClientActor
-	PickupQueue       *queue.Queue
+	Mu                     *sync.Mutex
+	Pending                *Pending
+	ElevatorCount          int
+	ElevatorPidList        *[]*actor.PID
+	ElevatorStatusMap      *sync.Map
+	ClientActor            *ClientActor
+	PickupQueue            *queue.Queue
+	DestinationDispatch    bool
+	DestinationAssignments *sync.Map
+	ElevatorHelloMap       *sync.Map
+	Feed                   *Feed
+	// Credentials sign every command, nil sends them unsigned
+	Credentials *auth.Credentials
+	// hall calls handed back by draining elevators, keyed by elevator id
+	Handoffs *sync.Map
+	// elevators running in this process, see Embedded
+	Embedded Embedded
+	Meter    *Meter
+	// Limiter caps the pickups per floor, nil admits them all
+	Limiter *Limiter
+	Mailbox *MailboxCounter
 }
 
 type ClientActor struct {
 	Client *Client
 	PID    *actor.PID
+	// Stops told to listeners since the last answer of each car, so the
+	// ones a multi step answer repeats are not published twice
+	announced map[uint32]map[uint64]bool
 }
 
 type ElevatorStatus struct {
-	Id    uint32
-	Floor uint32
-	State int32
-	Goal  int32
+	Id       uint32 `json:"id"`
+	Floor    uint32 `json:"floor"`
+	State    int32  `json:"state"`
+	Goal     int32  `json:"goal"`
+	Draining bool   `json:"draining"`
+	Faulted  bool   `json:"faulted"`
 }
 
 type StatusRequestOpt struct {
@@ -50,21 +87,141 @@ type PickupRequestItem struct {
 	State int32
 }
 
+type UpdateRequestItem struct {
+	Goal  uint32
+	State int32
+}
+
+type DestinationPickupRequestItem struct {
+	Floor       uint32
+	Destination uint32
+}
+
+// Assignment is the car a pickup went to, Id is NOT_FOUND while it waits
+// in the queue for a car to free up
+type Assignment struct {
+	Id     uint32 `json:"id"`
+	Queued bool   `json:"queued"`
+}
+
+// DestinationAssignment is the car grouping passengers for a destination
+type DestinationAssignment struct {
+	Id    uint32
+	State int32
+}
+
 func (ca *ClientActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *messages.HelloResponse:
+		ca.Client.Meter.answered(msg.Id)
+		ca.Client.ElevatorHelloMap.Store(msg.Id, msg)
+
+		ca.Client.Pending.Answer(msg.Request, msg.Id, nil)
 	case *messages.StatusResponse:
-		// Bit inefficient with memory here
-		ca.Client.ElevatorStatusMap.Store(
-			msg.Id,
-			&ElevatorStatus{
-				Id:    msg.Id,
-				Floor: msg.Floor,
-				Goal:  msg.Goal,
-				State: msg.State,
-			},
-		)
+		ca.Client.Meter.answered(msg.Id)
+		delete(ca.announced, msg.Id)
+		ca.storeStatus(msg)
+
+		ca.Client.Pending.Answer(msg.Request, msg.Id, refused(msg))
+	case *messages.MultiStepResponse:
+		ca.Client.Meter.answered(msg.Status.Id)
+		for _, event := range msg.Events {
+			if !ca.announced[event.Id][event.Stop] {
+				ca.arrived(event)
+			}
+		}
+		delete(ca.announced, msg.Status.Id)
+		ca.storeStatus(msg.Status)
+
+		ca.Client.Pending.Answer(msg.Status.Request, msg.Status.Id, refused(msg.Status), msg.Events...)
+	case *messages.ArrivalEvent:
+		// The group for this destination has been delivered
+		if v, ok := ca.Client.DestinationAssignments.Load(msg.Floor); ok &&
+			v.(*DestinationAssignment).Id == msg.Id {
+			ca.Client.DestinationAssignments.Delete(msg.Floor)
+		}
+		if ca.announced == nil {
+			ca.announced = make(map[uint32]map[uint64]bool)
+		}
+		if ca.announced[msg.Id] == nil {
+			ca.announced[msg.Id] = make(map[uint64]bool)
+		}
+		ca.announced[msg.Id][msg.Stop] = true
+		ca.arrived(msg)
+		logging.Elevator(msg.Id).WithField(logging.FIELD_FLOOR, msg.Floor).Debug("arrived")
+	case *messages.DrainEvent:
+		// Picked up by the next step, only holders of Mu may dispatch
+		ca.Client.Handoffs.Store(msg.Id, msg)
+		ca.Client.Meter.handoff(msg.Id)
+		if v, ok := ca.Client.ElevatorStatusMap.Load(msg.Id); ok {
+			status := *v.(*ElevatorStatus)
+			status.Draining = true
+			ca.Client.ElevatorStatusMap.Store(msg.Id, &status)
+		}
+		ca.Client.Feed.Publish(&Event{Type: EVENT_DRAINING, Id: msg.Id})
+		logging.Elevator(msg.Id).WithField("hall_calls", len(msg.HallCalls)).Warn("draining, reassigning hall calls")
+	case *messages.FaultEvent:
+		// Handed off like a drain, the car takes no more calls
+		ca.Client.Handoffs.Store(msg.Id, &messages.DrainEvent{Id: msg.Id, HallCalls: msg.HallCalls})
+		ca.Client.Meter.handoff(msg.Id)
+		if v, ok := ca.Client.ElevatorStatusMap.Load(msg.Id); ok {
+			status := *v.(*ElevatorStatus)
+			status.Draining = true
+			status.Faulted = true
+			ca.Client.ElevatorStatusMap.Store(msg.Id, &status)
+		}
+		ca.Client.Feed.Publish(&Event{Type: EVENT_FAULT, Id: msg.Id, Error: msg.Reason})
+		logging.Elevator(msg.Id).WithFields(log.Fields{
+			"restarts":   msg.Restarts,
+			"hall_calls": len(msg.HallCalls),
+		}).Error("out of service, reassigning hall calls")
+	}
+}
+
+func (ca *ClientActor) arrived(msg *messages.ArrivalEvent) {
+	ca.Client.Meter.arrived(msg.Id, msg.Floor)
+	ca.Client.Feed.Publish(&Event{
+		Type:  EVENT_ARRIVED,
+		Id:    msg.Id,
+		Floor: msg.Floor,
+		State: msg.State,
+	})
+}
 
-		ca.Client.Wg.Done()
+// refused is the error in msg, if any
+func refused(msg *messages.StatusResponse) error {
+	if msg.Error == "" {
+		return nil
+	}
+
+	return &RefusedError{Id: msg.Id, Reason: msg.Error}
+}
+
+func (ca *ClientActor) storeStatus(msg *messages.StatusResponse) {
+	status := &ElevatorStatus{
+		Id:       msg.Id,
+		Floor:    msg.Floor,
+		Goal:     msg.Goal,
+		State:    msg.State,
+		Draining: msg.Draining,
+		Faulted:  msg.Faulted,
+	}
+	// Bit inefficient with memory here
+	prev, loaded := ca.Client.ElevatorStatusMap.Load(msg.Id)
+	ca.Client.ElevatorStatusMap.Store(msg.Id, status)
+
+	if !loaded || *prev.(*ElevatorStatus) != *status {
+		ca.Client.Feed.Publish(&Event{
+			Type:  EVENT_STATUS,
+			Id:    status.Id,
+			Floor: status.Floor,
+			Goal:  status.Goal,
+			State: status.State,
+		})
+	}
+	// Ran out of goals
+	if loaded && prev.(*ElevatorStatus).State != 0 && status.State == 0 {
+		ca.Client.Feed.Publish(&Event{Type: EVENT_IDLE, Id: status.Id, Floor: status.Floor})
 	}
 }
 
@@ -76,55 +233,287 @@ func newClientActor(c *Client) actor.Producer {
 	}
 }
 
-func NewClient(bind string, elevatorCount int) *Client {
-	remote.Start(bind)
+func NewClient(bind string, elevatorCount int, options ...remote.RemotingOption) *Client {
+	remote.Start(bind, options...)
 	elevatorPidList := make([]*actor.PID, elevatorCount)
 	for i := 0; i < elevatorCount; i++ {
 		hostname := "127.0.0.1"
 		port := 9000 + i
 		binding := fmt.Sprintf("%s:%d", hostname, port)
 
-		elevatorPidList[i] = actor.NewPID(binding, string(i))
+		elevatorPidList[i] = actor.NewPID(binding, messages.ElevatorName(uint(i)))
 	}
+
+	return NewClientFor(elevatorPidList)
+}
+
+// NewClientFor spawns the client actor talking to the elevators at pids,
+// which may live in this process
+func NewClientFor(elevatorPidList []*actor.PID) *Client {
 	client := &Client{
-		Wg:                &sync.WaitGroup{},
-		ElevatorCount:     elevatorCount,
-		ElevatorPidList:   &elevatorPidList,
-		ElevatorStatusMap: &sync.Map{},
-		ClientActor:       &ClientActor{},
-		PickupQueue:       queue.New(),
+		Mu:                     &sync.Mutex{},
+		Pending:                NewPending(),
+		ElevatorCount:          len(elevatorPidList),
+		ElevatorPidList:        &elevatorPidList,
+		ElevatorStatusMap:      &sync.Map{},
+		ClientActor:            &ClientActor{},
+		PickupQueue:            queue.New(),
+		DestinationAssignments: &sync.Map{},
+		ElevatorHelloMap:       &sync.Map{},
+		Feed:                   NewFeed(),
+		Handoffs:               &sync.Map{},
+		Meter:                  NewMeter(),
+		// Answers and events are never shed, the client keeps up with
+		// the cars it talks to
+		Mailbox: &MailboxCounter{},
 	}
 	props := actor.FromProducer(newClientActor(client)).
-		WithMailbox(mailbox.Bounded(10000))
+		WithMailbox(mailbox.Bounded(MAILBOX_SIZE, client.Mailbox))
 	client.ClientActor.PID = actor.Spawn(props)
-	log.Println("client started")
+	log.WithField("elevators", len(elevatorPidList)).Info("client started")
 
 	return client
 }
 
-func (client *Client) SendStatusRequest(opt StatusRequestOpt) {
+// Hello exchanges protocol versions and capabilities with every elevator
+// and fails on the first incompatible one, or the first one that did not
+// answer before ctx ran out
+func (client *Client) Hello(ctx context.Context) error {
+	msg := &messages.HelloRequest{
+		Sender:   client.ClientActor.PID,
+		Version:  messages.PROTOCOL_VERSION,
+		Features: messages.Features,
+	}
+	// The cars missing an answer are named below
+	if _, err := client.broadcast(ctx, msg); err != nil && err != context.DeadlineExceeded {
+		return err
+	}
+
+	for id := 0; id < client.ElevatorCount; id++ {
+		if err := client.checkHello(uint32(id)); err != nil {
+			client.Feed.Publish(&Event{Type: EVENT_FAULT, Id: uint32(id), Error: err.Error()})
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendHelloRequest runs Hello for up to HELLO_TIMEOUT
+func (client *Client) SendHelloRequest() error {
+	ctx, cancel := context.WithTimeout(context.Background(), HELLO_TIMEOUT)
+	defer cancel()
+
+	return client.Hello(ctx)
+}
+
+// checkHello verifies the handshake answer of one elevator
+func (client *Client) checkHello(id uint32) error {
+	v, ok := client.ElevatorHelloMap.Load(id)
+	if !ok {
+		return fmt.Errorf("elevator %d did not answer the handshake", id)
+	}
+	hello := v.(*messages.HelloResponse)
+
+	switch {
+	case hello.Error != "":
+		return fmt.Errorf("elevator %d rejected the client: %s", id, hello.Error)
+	case hello.Version != messages.PROTOCOL_VERSION:
+		return fmt.Errorf("elevator %d speaks protocol version %d, expected %d",
+			id, hello.Version, messages.PROTOCOL_VERSION)
+	case hello.Floors != messages.FLOORS:
+		return fmt.Errorf("elevator %d has %d floors, expected %d",
+			id, hello.Floors, messages.FLOORS)
+	case client.DestinationDispatch &&
+		!messages.HasFeature(hello.Features, messages.FEATURE_DESTINATION):
+		return fmt.Errorf("elevator %d does not support destination dispatch", id)
+	}
+
+	return nil
+}
+
+// serves reports whether the elevator stops at floor, assuming
+// it does when no handshake took place
+func (client *Client) serves(id uint32, floor uint32) bool {
+	v, ok := client.ElevatorHelloMap.Load(id)
+	if !ok {
+		return true
+	}
+
+	for _, f := range v.(*messages.HelloResponse).ServedFloors {
+		if f == floor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// send tells msg to elevator id and waits for its answer
+func (client *Client) send(ctx context.Context, id int, msg interface{}) (status *ElevatorStatus, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "client.send", trace.WithAttributes(
+		attribute.Int("elevator.id", id),
+		attribute.String("message", messageName(msg)),
+	))
+	defer func() { tracing.End(span, err) }()
+	traced(ctx, msg)
+
+	request := client.Pending.Expect(1)
+	requested(msg, request)
+	if err := client.signed(msg); err != nil {
+		client.Pending.Forget(request)
+		return nil, err
+	}
+	client.Meter.send(uint32(id))
+	(*client.ElevatorPidList)[id].Tell(msg)
+	if err := client.wait(ctx, request, uint32(id)); err != nil {
+		return nil, err
+	}
+
+	return client.lastStatus(uint32(id)), nil
+}
+
+// broadcast tells msg to every elevator and waits for their answers
+func (client *Client) broadcast(ctx context.Context, msg interface{}) (request *Request, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "client.broadcast", trace.WithAttributes(
+		attribute.Int("elevators", client.ElevatorCount),
+		attribute.String("message", messageName(msg)),
+	))
+	defer func() { tracing.End(span, err) }()
+	traced(ctx, msg)
+
+	request = client.Pending.Expect(client.ElevatorCount)
+	requested(msg, request)
+	if err := client.signed(msg); err != nil {
+		client.Pending.Forget(request)
+		return nil, err
+	}
+	ids := make([]uint32, client.ElevatorCount)
+	for id, elevator := range *client.ElevatorPidList {
+		ids[id] = uint32(id)
+		client.Meter.send(uint32(id))
+		elevator.Tell(msg)
+	}
+
+	return request, client.wait(ctx, request, ids...)
+}
+
+// requested names request in msg for the answers to be matched to it
+func requested(msg interface{}, request *Request) {
+	if m, ok := msg.(messages.Requested); ok {
+		m.SetRequest(request.Id)
+	}
+}
+
+// traced hands the span in ctx on to the elevators receiving msg
+func traced(ctx context.Context, msg interface{}) {
+	if m, ok := msg.(messages.Traced); ok {
+		m.SetTraceParent(tracing.Inject(ctx))
+	}
+}
+
+// signed proves msg with the credentials of the client, msg must be
+// complete
+func (client *Client) signed(msg interface{}) error {
+	if m, ok := msg.(auth.Command); ok {
+		return client.Credentials.Sign(m)
+	}
+
+	return nil
+}
+
+func messageName(msg interface{}) string {
+	t := reflect.TypeOf(msg)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// wait collects the answers of the cars of ids to request, it is given
+// up on when ctx ends. The cars still owing an answer at the deadline are
+// published as unresponsive.
+func (client *Client) wait(ctx context.Context, request *Request, ids ...uint32) error {
+	err := client.Pending.Wait(ctx, request)
+	if err != nil && err == ctx.Err() {
+		client.Meter.abandon(err == context.DeadlineExceeded)
+	}
+	if err != nil && err == context.DeadlineExceeded {
+		for _, id := range ids {
+			if !request.Answered(id) {
+				client.Feed.Publish(&Event{Type: EVENT_UNRESPONSIVE, Id: id, Error: err.Error()})
+			}
+		}
+	}
+
+	return err
+}
+
+func (client *Client) lastStatus(id uint32) *ElevatorStatus {
+	v, ok := client.ElevatorStatusMap.Load(id)
+	if !ok {
+		return nil
+	}
+
+	return v.(*ElevatorStatus)
+}
+
+// Status asks every elevator for its status
+func (client *Client) Status(ctx context.Context) ([]*ElevatorStatus, error) {
 	msg := &messages.StatusRequest{Sender: client.ClientActor.PID}
+	if _, err := client.broadcast(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return client.CurrentStatus(), nil
+}
+
+// StatusOf asks a single elevator for its status
+func (client *Client) StatusOf(ctx context.Context, id int) (*ElevatorStatus, error) {
+	if err := client.ValidateId(id); err != nil {
+		return nil, err
+	}
+
+	return client.send(ctx, id, &messages.StatusRequest{Sender: client.ClientActor.PID})
+}
 
+// SendStatusRequest runs Status or StatusOf for up to HELLO_TIMEOUT, a
+// car not answering leaves its last known status
+func (client *Client) SendStatusRequest(opt StatusRequestOpt) {
+	ctx, cancel := context.WithTimeout(context.Background(), HELLO_TIMEOUT)
+	defer cancel()
+
+	var err error
 	if opt.BroadcastAll == true {
-		for _, elevator := range *client.ElevatorPidList {
-			client.Wg.Add(1)
-			elevator.Tell(msg)
-		}
+		_, err = client.Status(ctx)
 	} else {
-		client.Wg.Add(1)
-		(*client.ElevatorPidList)[opt.SinglePID].Tell(msg)
+		_, err = client.StatusOf(ctx, opt.SinglePID)
+	}
+	if err != nil {
+		log.WithError(err).Error("status request failed")
 	}
-
-	client.Wg.Wait()
 }
 
-func (client *Client) SendPickupRequest(floor uint32, state int32) {
+// selectCar finds the best car for a pickup at floor going state
+func (client *Client) selectCar(floor uint32, state int32) uint32 {
 	var shortestProximity int32 = math.MaxInt32
 	var selectedId uint32 = NOT_FOUND
 
 	// Try to optimize and have nearby elevator pick up
 	client.ElevatorStatusMap.Range(func(k, v interface{}) bool {
 		e := v.(*ElevatorStatus)
+		if e.Draining || !client.serves(e.Id, floor) {
+			return true
+		}
 		// See if we are going the same direction
 		if state == e.State {
 			// Select only those that are in range
@@ -149,7 +538,7 @@ func (client *Client) SendPickupRequest(floor uint32, state int32) {
 		client.ElevatorStatusMap.Range(func(k, v interface{}) bool {
 			e := v.(*ElevatorStatus)
 
-			if e.State == 0 {
+			if e.State == 0 && !e.Draining && client.serves(e.Id, floor) {
 				shortestProximity = 0
 				selectedId = e.Id
 				return false
@@ -159,64 +548,434 @@ func (client *Client) SendPickupRequest(floor uint32, state int32) {
 		})
 	}
 
-	if selectedId != NOT_FOUND {
-		msg := &messages.PickupRequest{
-			Sender: client.ClientActor.PID,
-			Floor:  floor,
-			State:  state,
+	return selectedId
+}
+
+// Pickup hands a hall call to the best car, it is queued until a later
+// step when none is free. Calls coming in faster than the Limiter allows
+// for their floor fail with a *RateLimitedError.
+func (client *Client) Pickup(ctx context.Context, floor uint32, state int32) (*Assignment, error) {
+	return client.pickup(ctx, floor, state, true)
+}
+
+// pickup runs Pickup, admit is false for the queued calls retried as they
+// were admitted already
+func (client *Client) pickup(ctx context.Context, floor uint32, state int32, admit bool) (a *Assignment, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "dispatch.pickup", trace.WithAttributes(
+		attribute.Int64("floor", int64(floor)),
+		attribute.Int64("direction", int64(state)),
+	))
+	defer func() { endDispatch(span, a, err) }()
+
+	if err := client.ValidateFloor(floor); err != nil {
+		return nil, err
+	}
+	if err := client.ValidateDirection(state); err != nil {
+		return nil, err
+	}
+	if admit {
+		if err := client.admit(ctx, floor, state); err != nil {
+			return nil, err
 		}
-		client.Wg.Add(1)
-		(*client.ElevatorPidList)[selectedId].Tell(msg)
-		client.Wg.Wait()
-	} else {
+	}
+
+	start := time.Now()
+	selectedId := client.selectCar(floor, state)
+	if selectedId == NOT_FOUND {
 		// Add to queue when no cars are available
 		client.PickupQueue.PushBack(PickupRequestItem{Floor: floor, State: state})
-		log.Println("all cars are busy!")
+		client.observeQueue()
+		client.Meter.queue(floor, state)
+		logging.Call(logging.Request(ctx), floor, state).Debug("pickup queued")
+		client.Feed.Publish(&Event{Type: EVENT_QUEUED, Floor: floor, State: state})
+		return &Assignment{Id: NOT_FOUND, Queued: true}, nil
+	}
+
+	client.Feed.Publish(&Event{Type: EVENT_ASSIGNED, Id: selectedId, Floor: floor, State: state})
+	logging.Call(logging.Request(ctx), floor, state).WithField(logging.FIELD_ELEVATOR, selectedId).Debug("pickup assigned")
+	client.Meter.assign(selectedId, floor, state, start)
+	msg := &messages.PickupRequest{
+		Sender: client.ClientActor.PID,
+		Floor:  floor,
+		State:  state,
 	}
+	if _, err := client.send(ctx, int(selectedId), msg); err != nil {
+		return nil, err
+	}
+	assignmentSeconds.Observe(time.Since(start).Seconds())
+
+	return &Assignment{Id: selectedId}, nil
 }
 
-func (client *Client) SendUpdateRequest(id int, goal uint32, state int32) {
+// admit takes a hall call at floor from the Limiter
+func (client *Client) admit(ctx context.Context, floor uint32, state int32) error {
+	if client.Limiter.Allow(floor) {
+		return nil
+	}
+	pickupsLimited.WithLabelValues(strconv.FormatUint(uint64(floor), 10)).Inc()
+	logging.Call(logging.Request(ctx), floor, state).Debug("pickup rate limited")
+
+	return &RateLimitedError{Floor: floor}
+}
+
+// endDispatch records where a pickup went on span and ends it
+func endDispatch(span trace.Span, a *Assignment, err error) {
+	if a != nil {
+		span.SetAttributes(attribute.Bool("queued", a.Queued))
+		if !a.Queued {
+			span.SetAttributes(attribute.Int64("elevator.id", int64(a.Id)))
+		}
+	}
+	tracing.End(span, err)
+}
+
+// selectGroupedCar finds a car already taking passengers to destination
+// that can still stop at floor on its way
+func (client *Client) selectGroupedCar(floor uint32, destination uint32, state int32) uint32 {
+	v, ok := client.DestinationAssignments.Load(destination)
+	if !ok {
+		return NOT_FOUND
+	}
+	a := v.(*DestinationAssignment)
+	if a.State != state || !client.serves(a.Id, floor) {
+		return NOT_FOUND
+	}
+
+	s, ok := client.ElevatorStatusMap.Load(a.Id)
+	if !ok {
+		return NOT_FOUND
+	}
+	e := s.(*ElevatorStatus)
+	if e.Draining {
+		return NOT_FOUND
+	}
+	// An idle car has not left yet, a moving one must not have passed us
+	if e.State == 0 ||
+		(state == 1 && e.Floor <= floor) ||
+		(state == -1 && e.Floor >= floor) {
+		return a.Id
+	}
+
+	return NOT_FOUND
+}
+
+// DestinationPickup hands a passenger going from floor to destination to
+// a car, grouping passengers by destination when enabled. It is rate
+// limited like Pickup.
+func (client *Client) DestinationPickup(ctx context.Context, floor uint32, destination uint32) (*Assignment, error) {
+	return client.destinationPickup(ctx, floor, destination, true)
+}
+
+// destinationPickup runs DestinationPickup, admit is false for the queued
+// passengers retried
+func (client *Client) destinationPickup(ctx context.Context, floor uint32, destination uint32, admit bool) (a *Assignment, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "dispatch.destination_pickup", trace.WithAttributes(
+		attribute.Int64("floor", int64(floor)),
+		attribute.Int64("destination", int64(destination)),
+	))
+	defer func() { endDispatch(span, a, err) }()
+
+	if err := client.ValidateDestination(floor, destination); err != nil {
+		return nil, err
+	}
+
+	var state int32 = 1
+	if destination < floor {
+		state = -1
+	}
+	if admit {
+		if err := client.admit(ctx, floor, state); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	selectedId := NOT_FOUND
+	if client.DestinationDispatch {
+		selectedId = client.selectGroupedCar(floor, destination, state)
+	}
+	if selectedId == NOT_FOUND {
+		selectedId = client.selectCar(floor, state)
+	}
+
+	if selectedId == NOT_FOUND {
+		// Add to queue when no cars are available
+		client.PickupQueue.PushBack(DestinationPickupRequestItem{Floor: floor, Destination: destination})
+		client.observeQueue()
+		client.Meter.queue(floor, state)
+		logging.Call(logging.Request(ctx), floor, state).Debug("pickup queued")
+		client.Feed.Publish(&Event{Type: EVENT_QUEUED, Floor: floor, Goal: int32(destination), State: state})
+		return &Assignment{Id: NOT_FOUND, Queued: true}, nil
+	}
+
+	if client.DestinationDispatch {
+		client.DestinationAssignments.Store(
+			destination,
+			&DestinationAssignment{Id: selectedId, State: state},
+		)
+	}
+	client.Feed.Publish(&Event{Type: EVENT_ASSIGNED, Id: selectedId, Floor: floor, Goal: int32(destination), State: state})
+	logging.Call(logging.Request(ctx), floor, state).WithField(logging.FIELD_ELEVATOR, selectedId).Debug("pickup assigned")
+	client.Meter.assign(selectedId, floor, state, start)
+	msg := &messages.DestinationPickupRequest{
+		Sender:      client.ClientActor.PID,
+		Floor:       floor,
+		Destination: destination,
+	}
+	if _, err := client.send(ctx, int(selectedId), msg); err != nil {
+		return nil, err
+	}
+	assignmentSeconds.Observe(time.Since(start).Seconds())
+
+	return &Assignment{Id: selectedId}, nil
+}
+
+// Update sends elevator id to goal going state
+func (client *Client) Update(ctx context.Context, id int, goal uint32, state int32) (*ElevatorStatus, error) {
+	if err := client.ValidateId(id); err != nil {
+		return nil, err
+	}
+	if err := client.ValidateFloor(goal); err != nil {
+		return nil, err
+	}
+	if err := client.ValidateDirection(state); err != nil {
+		return nil, err
+	}
+
 	msg := &messages.UpdateRequest{
 		Sender: client.ClientActor.PID,
 		Goal:   goal,
 		State:  state,
 	}
-	client.Wg.Add(1)
-	(*client.ElevatorPidList)[id].Tell(msg)
-	client.Wg.Wait()
+
+	return client.send(ctx, id, msg)
 }
 
-func (client *Client) SendStepRequest() {
+// Cancel drops the calls for floor on one elevator along with any
+// queued pickups waiting on that floor
+func (client *Client) Cancel(ctx context.Context, id int, floor uint32) (*ElevatorStatus, error) {
+	if err := client.ValidateId(id); err != nil {
+		return nil, err
+	}
+	if err := client.ValidateFloor(floor); err != nil {
+		return nil, err
+	}
+
+	for amt := client.PickupQueue.Len(); amt > 0; amt-- {
+		item := client.PickupQueue.PopFront()
+		switch pqi := item.(type) {
+		case PickupRequestItem:
+			if pqi.Floor == floor {
+				continue
+			}
+		case DestinationPickupRequestItem:
+			if pqi.Floor == floor {
+				continue
+			}
+		}
+		client.PickupQueue.PushBack(item)
+	}
+
+	if v, ok := client.DestinationAssignments.Load(floor); ok &&
+		v.(*DestinationAssignment).Id == uint32(id) {
+		client.DestinationAssignments.Delete(floor)
+	}
+
+	msg := &messages.CancelRequest{
+		Sender: client.ClientActor.PID,
+		Floor:  floor,
+	}
+
+	return client.send(ctx, id, msg)
+}
+
+// Batch applies a list of UpdateRequestItem and PickupRequestItem to a
+// single elevator in one round trip
+func (client *Client) Batch(ctx context.Context, id int, items []interface{}) (*ElevatorStatus, error) {
+	if err := client.ValidateId(id); err != nil {
+		return nil, err
+	}
+
+	commands := make([]*messages.Command, 0, len(items))
+	for _, item := range items {
+		var floor uint32
+		var state int32
+		switch i := item.(type) {
+		case UpdateRequestItem:
+			floor, state = i.Goal, i.State
+			commands = append(commands, &messages.Command{
+				Command: &messages.Command_Update{
+					Update: &messages.UpdateRequest{Goal: i.Goal, State: i.State},
+				},
+			})
+		case PickupRequestItem:
+			floor, state = i.Floor, i.State
+			commands = append(commands, &messages.Command{
+				Command: &messages.Command_Pickup{
+					Pickup: &messages.PickupRequest{Floor: i.Floor, State: i.State},
+				},
+			})
+		default:
+			return nil, &ValidationError{fmt.Sprintf("unsupported batch item %T", item)}
+		}
+
+		if err := client.ValidateFloor(floor); err != nil {
+			return nil, err
+		}
+		if err := client.ValidateDirection(state); err != nil {
+			return nil, err
+		}
+	}
+
+	msg := &messages.BatchRequest{
+		Sender:   client.ClientActor.PID,
+		Commands: commands,
+	}
+
+	return client.send(ctx, id, msg)
+}
+
+// Step advances every car one floor and dispatches the queued pickups
+func (client *Client) Step(ctx context.Context) (statuses []*ElevatorStatus, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "dispatch.step")
+	defer func() { tracing.End(span, err) }()
+
 	msg := &messages.StepRequest{
 		Sender: client.ClientActor.PID,
 	}
-	for _, elevator := range *client.ElevatorPidList {
-		client.Wg.Add(1)
-		elevator.Tell(msg)
+	if _, err := client.broadcast(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := client.processPickupQueue(ctx); err != nil {
+		return nil, err
+	}
+
+	return client.CurrentStatus(), nil
+}
+
+// takeHandoffs queues the hall calls of draining elevators so they are
+// dispatched to other cars
+func (client *Client) takeHandoffs() {
+	client.Handoffs.Range(func(k, v interface{}) bool {
+		client.Handoffs.Delete(k)
+		event := v.(*messages.DrainEvent)
+
+		for _, call := range event.HallCalls {
+			if len(call.Destinations) == 0 {
+				client.PickupQueue.PushBack(PickupRequestItem{Floor: call.Floor, State: call.State})
+			}
+			for _, destination := range call.Destinations {
+				client.PickupQueue.PushBack(DestinationPickupRequestItem{Floor: call.Floor, Destination: destination})
+			}
+		}
+
+		// Groups riding the car are not joined by anyone else
+		client.DestinationAssignments.Range(func(d, a interface{}) bool {
+			if a.(*DestinationAssignment).Id == event.Id {
+				client.DestinationAssignments.Delete(d)
+			}
+			return true
+		})
+
+		return true
+	})
+}
+
+// processPickupQueue retries the pickups that found no car
+func (client *Client) processPickupQueue(ctx context.Context) (err error) {
+	client.takeHandoffs()
+	if client.PickupQueue.Len() == 0 {
+		return nil
 	}
-	client.Wg.Wait()
 
-	// Process queue items
+	ctx, span := tracing.Tracer().Start(ctx, "dispatch.queue", trace.WithAttributes(
+		attribute.Int("queued", client.PickupQueue.Len()),
+	))
+	defer func() { tracing.End(span, err) }()
+
 	for amt := client.PickupQueue.Len(); amt > 0; amt-- {
-		pqi := client.PickupQueue.PopFront().(PickupRequestItem)
-		client.SendPickupRequest(pqi.Floor, pqi.State)
+		var err error
+		item := client.PickupQueue.PopFront()
+		switch pqi := item.(type) {
+		case PickupRequestItem:
+			_, err = client.pickup(ctx, pqi.Floor, pqi.State, false)
+		case DestinationPickupRequestItem:
+			_, err = client.destinationPickup(ctx, pqi.Floor, pqi.Destination, false)
+		}
+		if err != nil {
+			// The pickup keeps its place for the next step
+			client.PickupQueue.PushFront(item)
+			client.observeQueue()
+			return err
+		}
+	}
+	client.observeQueue()
+
+	return nil
+}
+
+// Steps advances every car count steps, or until idle, and returns the
+// arrivals that happened on the way. count must be positive unless
+// untilIdle is set.
+func (client *Client) Steps(ctx context.Context, count uint32, untilIdle bool) (events []*messages.ArrivalEvent, err error) {
+	if count == 0 && !untilIdle {
+		return nil, &ValidationError{"step count must be positive, or step until idle"}
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "dispatch.step", trace.WithAttributes(
+		attribute.Int64("count", int64(count)),
+		attribute.Bool("until_idle", untilIdle),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	msg := &messages.MultiStepRequest{
+		Sender:    client.ClientActor.PID,
+		Count:     count,
+		UntilIdle: untilIdle,
+	}
+	request, err := client.broadcast(ctx, msg)
+	if err != nil {
+		return nil, err
 	}
+	events = request.Events()
+
+	// Queued pickups are retried once for the whole run
+	if err := client.processPickupQueue(ctx); err != nil {
+		return events, err
+	}
+
+	return events, nil
+}
+
+// CurrentStatus returns the last known status of every elevator by id
+func (client *Client) CurrentStatus() []*ElevatorStatus {
+	statuses := []*ElevatorStatus{}
+	for id := 0; id < client.ElevatorCount; id++ {
+		if v, ok := client.ElevatorStatusMap.Load(uint32(id)); ok {
+			statuses = append(statuses, v.(*ElevatorStatus))
+		}
+	}
+
+	return statuses
 }
 
 func (client *Client) PrintCurrentStatus() {
+	PrintStatus(client.CurrentStatus())
+}
+
+// PrintStatus renders statuses as a table on stdout
+func PrintStatus(statuses []*ElevatorStatus) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"ID", "Floor", "Goal", "State"})
 
-	client.ElevatorStatusMap.Range(func(k, v interface{}) bool {
-		e := v.(*ElevatorStatus)
+	for _, e := range statuses {
 		table.Append([]string{
 			strconv.Itoa(int(e.Id)),
 			strconv.Itoa(int(e.Floor)),
 			strconv.Itoa(int(e.Goal)),
 			strconv.Itoa(int(e.State)),
 		})
-
-		return true
-	})
+	}
 	table.Render()
 }
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"dec/service/elevator"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func TestHelloTimeout(t *testing.T) {
+	// a car that never answers
+	silent := actor.Spawn(actor.FromFunc(func(actor.Context) {}))
+	defer silent.Stop()
+
+	c := NewClientFor([]*actor.PID{silent})
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := c.Hello(ctx)
+	if err == nil || !strings.Contains(err.Error(), "did not answer the handshake") {
+		t.Error("Expected the car to be named, got ", err)
+	}
+}
+
+func TestDeadElevator(t *testing.T) {
+	services, err := elevator.SpawnEmbedded([]uint{0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer services.Stop(time.Second)
+	silent := actor.Spawn(actor.FromFunc(func(actor.Context) {}))
+	defer silent.Stop()
+
+	c := NewClientFor([]*actor.PID{services[0].PID, silent})
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.StatusOf(ctx, 1); err != context.DeadlineExceeded {
+		t.Fatal("Expected ", context.DeadlineExceeded, ", got ", err)
+	}
+
+	// the car that timed out is reported as unhealthy
+	unhealthy := make(chan *Event, 1)
+	defer c.Feed.OnUnhealthy(func(event *Event) { unhealthy <- event })()
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c.StatusOf(ctx, 1)
+	select {
+	case event := <-unhealthy:
+		if event.Type != EVENT_UNRESPONSIVE || event.Id != 1 {
+			t.Error("Expected car 1 to be unresponsive, got ", event)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected an unresponsive event")
+	}
+
+	// the car that answers is not held up by the one that did not
+	if _, err := c.StatusOf(context.Background(), 0); err != nil {
+		t.Error("Expected no error, got ", err)
+	}
+}
+
+func TestQueuedPickupFailure(t *testing.T) {
+	// a car that never answers
+	silent := actor.Spawn(actor.FromFunc(func(actor.Context) {}))
+	defer silent.Stop()
+
+	c := NewClientFor([]*actor.PID{silent})
+	defer c.Close()
+	c.ElevatorStatusMap.Store(uint32(0), &ElevatorStatus{Id: 0})
+	c.PickupQueue.PushBack(PickupRequestItem{Floor: 3, State: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.processPickupQueue(ctx); err != context.DeadlineExceeded {
+		t.Fatal("Expected ", context.DeadlineExceeded, ", got ", err)
+	}
+
+	if c.PickupQueue.Len() != 1 {
+		t.Fatal("Expected the pickup to stay queued, got ", c.PickupQueue.Len())
+	}
+	if item := c.PickupQueue.Front().(PickupRequestItem); item.Floor != 3 || item.State != 1 {
+		t.Error("Expected pickup at floor 3 going up, got ", item)
+	}
+}
+
+func TestValidateDestination(t *testing.T) {
+	c := &Client{}
+	if err := c.ValidateDestination(3, 9); err != nil {
+		t.Error("Expected no error, got ", err)
+	}
+	if _, ok := c.ValidateDestination(3, 3).(*ValidationError); !ok {
+		t.Error("Expected a call to its own floor to be invalid")
+	}
+	if _, ok := c.ValidateDestination(3, 16).(*ValidationError); !ok {
+		t.Error("Expected a destination out of range to be invalid")
+	}
+}
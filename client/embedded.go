@@ -0,0 +1,27 @@
+package client
+
+import (
+	"time"
+)
+
+// Time Close gives the embedded elevators to save their state
+const EMBEDDED_STOP_TIMEOUT = 5 * time.Second
+
+// Embedded is a group of elevators running as actors of this process next
+// to the client, see group.NewEmbeddedClient. The client only stops it,
+// so it does not link the elevator service.
+type Embedded interface {
+	Stop(timeout time.Duration) error
+}
+
+// Close stops the client and the elevators it embeds
+func (client *Client) Close() error {
+	var err error
+	if client.Embedded != nil {
+		err = client.Embedded.Stop(EMBEDDED_STOP_TIMEOUT)
+		client.Embedded = nil
+	}
+	client.ClientActor.PID.Stop()
+
+	return err
+}
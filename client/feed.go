@@ -0,0 +1,168 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// Event types published on the feed
+const (
+	EVENT_STATUS   = "status"
+	EVENT_ASSIGNED = "assigned"
+	EVENT_QUEUED   = "queued"
+	EVENT_FAULT    = "fault"
+	EVENT_DRAINING = "draining"
+	EVENT_ARRIVED  = "arrived"
+	EVENT_IDLE     = "idle"
+	// A car did not answer a request before its deadline
+	EVENT_UNRESPONSIVE = "unresponsive"
+)
+
+// Events buffered per handler before the feed starts dropping
+const HANDLER_BUFFER = 256
+
+type Event struct {
+	Type  string    `json:"type"`
+	Time  time.Time `json:"time"`
+	Id    uint32    `json:"id"`
+	Floor uint32    `json:"floor"`
+	Goal  int32     `json:"goal"`
+	State int32     `json:"state"`
+	Error string    `json:"error,omitempty"`
+}
+
+// EventFilter keeps the events of the listed elevators and types,
+// every event passes when a list is empty
+type EventFilter struct {
+	Ids   map[uint32]bool
+	Types map[string]bool
+}
+
+func NewEventFilter(ids []uint32, types []string) *EventFilter {
+	f := &EventFilter{
+		Ids:   make(map[uint32]bool),
+		Types: make(map[string]bool),
+	}
+	for _, id := range ids {
+		f.Ids[id] = true
+	}
+	for _, t := range types {
+		f.Types[t] = true
+	}
+
+	return f
+}
+
+func (f *EventFilter) Match(e *Event) bool {
+	if len(f.Types) > 0 && !f.Types[e.Type] {
+		return false
+	}
+	// Queued pickups belong to no elevator yet
+	if len(f.Ids) > 0 && (e.Type == EVENT_QUEUED || !f.Ids[e.Id]) {
+		return false
+	}
+
+	return true
+}
+
+// Handler is called with the events a subscriber registered for
+type Handler func(e *Event)
+
+// Feed fans out events to every subscriber. Publishing never blocks,
+// events are dropped for subscribers that fall behind.
+type Feed struct {
+	mu          sync.Mutex
+	subscribers map[chan *Event]*EventFilter
+}
+
+func NewFeed() *Feed {
+	return &Feed{
+		subscribers: make(map[chan *Event]*EventFilter),
+	}
+}
+
+// Subscribe returns a channel receiving every event published from now on
+func (f *Feed) Subscribe(buffer int) chan *Event {
+	return f.SubscribeFilter(buffer, nil)
+}
+
+// SubscribeFilter returns a channel receiving the events from now on
+// that pass filter, every event passes a nil filter
+func (f *Feed) SubscribeFilter(buffer int, filter *EventFilter) chan *Event {
+	ch := make(chan *Event, buffer)
+
+	f.mu.Lock()
+	f.subscribers[ch] = filter
+	f.mu.Unlock()
+
+	return ch
+}
+
+// On calls h with every event of the given types, in the order they were
+// published. Handlers run on a goroutine of their own so they may call
+// back into the client. The returned func stops the calls.
+func (f *Feed) On(h Handler, types ...string) func() {
+	ch := f.SubscribeFilter(HANDLER_BUFFER, NewEventFilter(nil, types))
+	go func() {
+		for e := range ch {
+			h(e)
+		}
+	}()
+
+	return func() { f.Unsubscribe(ch) }
+}
+
+// OnAssigned calls h when a hall call goes to a car
+func (f *Feed) OnAssigned(h Handler) func() {
+	return f.On(h, EVENT_ASSIGNED)
+}
+
+// OnQueued calls h when every car is busy and a hall call waits for a
+// later step
+func (f *Feed) OnQueued(h Handler) func() {
+	return f.On(h, EVENT_QUEUED)
+}
+
+// OnArrived calls h when a car stops at one of its goals
+func (f *Feed) OnArrived(h Handler) func() {
+	return f.On(h, EVENT_ARRIVED)
+}
+
+// OnIdle calls h when a car runs out of goals
+func (f *Feed) OnIdle(h Handler) func() {
+	return f.On(h, EVENT_IDLE)
+}
+
+// OnUnhealthy calls h when a car is taken out of service, fails the
+// handshake or does not answer in time
+func (f *Feed) OnUnhealthy(h Handler) func() {
+	return f.On(h, EVENT_FAULT, EVENT_UNRESPONSIVE)
+}
+
+// Unsubscribe stops delivery to ch and closes it
+func (f *Feed) Unsubscribe(ch chan *Event) {
+	f.mu.Lock()
+	if _, ok := f.subscribers[ch]; ok {
+		delete(f.subscribers, ch)
+		close(ch)
+	}
+	f.mu.Unlock()
+}
+
+func (f *Feed) Publish(e *Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	f.mu.Lock()
+	for ch, filter := range f.subscribers {
+		if filter != nil && !filter.Match(e) {
+			continue
+		}
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+	f.mu.Unlock()
+}
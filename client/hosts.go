@@ -0,0 +1,57 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"dec/internal/ids"
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// Hosts maps elevators to the address of the service hosting them. It is
+// a flag.Value taking address=ids, e.g. 127.0.0.1:9000=0-7, and may be
+// given once per service.
+type Hosts map[uint]string
+
+func (h Hosts) String() string {
+	parts := []string{}
+	for id, address := range h {
+		parts = append(parts, fmt.Sprintf("%s=%d", address, id))
+	}
+	sort.Strings(parts)
+
+	return strings.Join(parts, " ")
+}
+
+func (h Hosts) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("expected address=ids, got %q", value)
+	}
+
+	list, err := ids.Parse(parts[1])
+	if err != nil {
+		return err
+	}
+	for _, id := range list {
+		h[id] = parts[0]
+	}
+
+	return nil
+}
+
+// Locate points the client at elevators sharing a service. Elevators
+// missing from hosts keep their own process on port 9000+id.
+func (client *Client) Locate(hosts Hosts) error {
+	for id, address := range hosts {
+		if int(id) >= client.ElevatorCount {
+			return fmt.Errorf("elevator %d out of range [0, %d)", id, client.ElevatorCount)
+		}
+		(*client.ElevatorPidList)[id] = actor.NewPID(address, messages.ElevatorName(id))
+	}
+
+	return nil
+}
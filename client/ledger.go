@@ -0,0 +1,109 @@
+package client
+
+import (
+	"dec/messages"
+)
+
+// Kinds of queued pickups in a ledger
+const (
+	LEDGER_PICKUP      = "pickup"
+	LEDGER_DESTINATION = "destination"
+)
+
+// LedgerEntry is a pickup waiting in the queue
+type LedgerEntry struct {
+	Kind        string `json:"kind"`
+	Floor       uint32 `json:"floor"`
+	State       int32  `json:"state,omitempty"`
+	Destination uint32 `json:"destination,omitempty"`
+}
+
+// Ledger is the dispatch state another client needs to take over from
+// this one: pending pickups, destination assignments, the hall calls
+// handed off by draining cars and the last known status and handshake of
+// every car
+type Ledger struct {
+	Queue       []LedgerEntry                    `json:"queue"`
+	Assignments map[uint32]DestinationAssignment `json:"assignments"`
+	Handoffs    []*messages.DrainEvent           `json:"handoffs"`
+	Statuses    []*ElevatorStatus                `json:"statuses"`
+	Hellos      []*messages.HelloResponse        `json:"hellos"`
+}
+
+// Snapshot copies the dispatch state of the client
+func (client *Client) Snapshot() *Ledger {
+	ledger := &Ledger{
+		Queue:       []LedgerEntry{},
+		Assignments: map[uint32]DestinationAssignment{},
+		Handoffs:    []*messages.DrainEvent{},
+		Statuses:    client.CurrentStatus(),
+		Hellos:      []*messages.HelloResponse{},
+	}
+
+	// Rotate through the queue to keep its order
+	for amt := client.PickupQueue.Len(); amt > 0; amt-- {
+		item := client.PickupQueue.PopFront()
+		switch pqi := item.(type) {
+		case PickupRequestItem:
+			ledger.Queue = append(ledger.Queue, LedgerEntry{Kind: LEDGER_PICKUP, Floor: pqi.Floor, State: pqi.State})
+		case DestinationPickupRequestItem:
+			ledger.Queue = append(ledger.Queue, LedgerEntry{Kind: LEDGER_DESTINATION, Floor: pqi.Floor, Destination: pqi.Destination})
+		}
+		client.PickupQueue.PushBack(item)
+	}
+
+	client.DestinationAssignments.Range(func(k, v interface{}) bool {
+		ledger.Assignments[k.(uint32)] = *v.(*DestinationAssignment)
+		return true
+	})
+
+	client.Handoffs.Range(func(k, v interface{}) bool {
+		ledger.Handoffs = append(ledger.Handoffs, v.(*messages.DrainEvent))
+		return true
+	})
+
+	for id := 0; id < client.ElevatorCount; id++ {
+		if v, ok := client.ElevatorHelloMap.Load(uint32(id)); ok {
+			ledger.Hellos = append(ledger.Hellos, v.(*messages.HelloResponse))
+		}
+	}
+
+	return ledger
+}
+
+// Restore replaces the dispatch state of the client with ledger
+func (client *Client) Restore(ledger *Ledger) {
+	client.PickupQueue.Init()
+	for _, entry := range ledger.Queue {
+		switch entry.Kind {
+		case LEDGER_PICKUP:
+			client.PickupQueue.PushBack(PickupRequestItem{Floor: entry.Floor, State: entry.State})
+		case LEDGER_DESTINATION:
+			client.PickupQueue.PushBack(DestinationPickupRequestItem{Floor: entry.Floor, Destination: entry.Destination})
+		}
+	}
+
+	client.DestinationAssignments.Range(func(k, v interface{}) bool {
+		client.DestinationAssignments.Delete(k)
+		return true
+	})
+	for destination, a := range ledger.Assignments {
+		assignment := a
+		client.DestinationAssignments.Store(destination, &assignment)
+	}
+
+	client.Handoffs.Range(func(k, v interface{}) bool {
+		client.Handoffs.Delete(k)
+		return true
+	})
+	for _, handoff := range ledger.Handoffs {
+		client.Handoffs.Store(handoff.Id, handoff)
+	}
+
+	for _, status := range ledger.Statuses {
+		client.ElevatorStatusMap.Store(status.Id, status)
+	}
+	for _, hello := range ledger.Hellos {
+		client.ElevatorHelloMap.Store(hello.Id, hello)
+	}
+}
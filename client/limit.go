@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitedError is a pickup turned down for coming in faster than
+// its floor is allowed
+type RateLimitedError struct {
+	Floor uint32
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("too many pickups at floor %d, try again later", e.Floor)
+}
+
+// Limiter admits the pickups of every floor at a steady rate, letting
+// bursts through up to a point. A nil Limiter admits every pickup.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  rate.Limit
+	burst  int
+	floors map[uint32]*rate.Limiter
+}
+
+// NewLimiter admits perSecond pickups per floor and burst at once,
+// nil when perSecond is not positive
+func NewLimiter(perSecond float64, burst int) *Limiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		limit:  rate.Limit(perSecond),
+		burst:  burst,
+		floors: make(map[uint32]*rate.Limiter),
+	}
+}
+
+// Allow takes a pickup at floor if its rate permits
+func (l *Limiter) Allow(floor uint32) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.floors[floor]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.floors[floor] = limiter
+	}
+
+	return limiter.Allow()
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(0.001, 2)
+	if !l.Allow(3) || !l.Allow(3) {
+		t.Error("Expected the burst to be admitted")
+	}
+	if l.Allow(3) {
+		t.Error("Expected a pickup past the burst to be limited")
+	}
+	if !l.Allow(4) {
+		t.Error("Expected floors to be limited apart")
+	}
+
+	var unlimited *Limiter
+	if !unlimited.Allow(3) {
+		t.Error("Expected a nil limiter to admit every pickup")
+	}
+}
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	queueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "dispatch_queue_depth",
+		Help: "Hall calls waiting in the queue for a car to free up.",
+	})
+	assignmentSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "dispatch_assignment_latency_seconds",
+		Help:    "Time from a pickup request until the chosen car took the call.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+	})
+	hallCallSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "dispatch_hall_call_wait_seconds",
+		Help:    "Time from a pickup request, queued or not, until a car opened at the floor.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 16),
+	})
+	requestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dispatch_request_duration_seconds",
+		Help:    "Round trip of a request to an elevator.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"elevator"})
+	requestTimeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dispatch_request_timeouts_total",
+		Help: "Requests to an elevator given up on before it answered.",
+	}, []string{"elevator"})
+	mailboxDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "dispatch_mailbox_depth",
+		Help: "Answers and events from the elevators waiting in the mailbox of the client.",
+	})
+	pickupsLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dispatch_pickups_rate_limited_total",
+		Help: "Pickups turned down for coming in faster than their floor allows.",
+	}, []string{"floor"})
+)
+
+func init() {
+	prometheus.MustRegister(queueDepth, assignmentSeconds, hallCallSeconds, requestSeconds, requestTimeouts, mailboxDepth, pickupsLimited)
+}
+
+type hallCall struct {
+	Floor uint32
+	State int32
+}
+
+type waitingCall struct {
+	hallCall
+	since time.Time
+}
+
+// Meter times the requests of a client and the hall calls it
+// dispatched. A nil Meter measures nothing.
+type Meter struct {
+	mu sync.Mutex
+	// Requests sent to each car not answered yet, oldest first
+	sent map[uint32][]time.Time
+	// Hall calls waiting in the queue
+	queued map[hallCall]time.Time
+	// Hall calls each car took and has not opened at yet
+	waiting map[uint32][]waitingCall
+}
+
+func NewMeter() *Meter {
+	return &Meter{
+		sent:    make(map[uint32][]time.Time),
+		queued:  make(map[hallCall]time.Time),
+		waiting: make(map[uint32][]waitingCall),
+	}
+}
+
+func elevatorLabel(id uint32) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func (m *Meter) send(id uint32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent[id] = append(m.sent[id], time.Now())
+}
+
+func (m *Meter) answered(id uint32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Late answer to a request given up on
+	if len(m.sent[id]) == 0 {
+		return
+	}
+	requestSeconds.WithLabelValues(elevatorLabel(id)).Observe(time.Since(m.sent[id][0]).Seconds())
+	m.sent[id] = m.sent[id][1:]
+}
+
+// abandon forgets the requests still out, counting them as timeouts when
+// the deadline was hit
+func (m *Meter) abandon(timeout bool) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, sent := range m.sent {
+		if timeout {
+			requestTimeouts.WithLabelValues(elevatorLabel(id)).Add(float64(len(sent)))
+		}
+		delete(m.sent, id)
+	}
+}
+
+// queue starts the wait of a call no car could take, retries keep the
+// time of the first try
+func (m *Meter) queue(floor uint32, state int32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	call := hallCall{Floor: floor, State: state}
+	if _, ok := m.queued[call]; !ok {
+		m.queued[call] = time.Now()
+	}
+}
+
+// assign hands the wait of a call requested at since to car id
+func (m *Meter) assign(id uint32, floor uint32, state int32, since time.Time) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	call := hallCall{Floor: floor, State: state}
+	if queued, ok := m.queued[call]; ok {
+		since = queued
+		delete(m.queued, call)
+	}
+	m.waiting[id] = append(m.waiting[id], waitingCall{hallCall: call, since: since})
+}
+
+// arrived ends the wait of the calls car id took at floor, only arrival
+// events count since a car passing by or parked at the floor has not
+// opened for the call
+func (m *Meter) arrived(id uint32, floor uint32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	calls := m.waiting[id][:0]
+	for _, call := range m.waiting[id] {
+		if call.Floor == floor {
+			hallCallSeconds.Observe(time.Since(call.since).Seconds())
+			continue
+		}
+		calls = append(calls, call)
+	}
+	m.waiting[id] = calls
+}
+
+// handoff moves the calls car id took back to the queue, they keep
+// waiting for another car since they were first requested
+func (m *Meter) handoff(id uint32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, call := range m.waiting[id] {
+		if queued, ok := m.queued[call.hallCall]; !ok || call.since.Before(queued) {
+			m.queued[call.hallCall] = call.since
+		}
+	}
+	delete(m.waiting, id)
+}
+
+func (client *Client) observeQueue() {
+	queueDepth.Set(float64(client.PickupQueue.Len()))
+}
+
+// MailboxCounter follows the depth of the mailbox of the client actor,
+// it is handed to the mailbox as its statistics
+type MailboxCounter struct {
+	posted   int64
+	received int64
+}
+
+func (c *MailboxCounter) MailboxStarted() {}
+
+func (c *MailboxCounter) MessagePosted(message interface{}) {
+	mailboxDepth.Set(float64(atomic.AddInt64(&c.posted, 1) - atomic.LoadInt64(&c.received)))
+}
+
+func (c *MailboxCounter) MessageReceived(message interface{}) {
+	received := atomic.AddInt64(&c.received, 1)
+	mailboxDepth.Set(float64(atomic.LoadInt64(&c.posted) - received))
+}
+
+func (c *MailboxCounter) MailboxEmpty() {}
+
+// Depth is the amount of messages posted but not received yet
+func (c *MailboxCounter) Depth() int64 {
+	return atomic.LoadInt64(&c.posted) - atomic.LoadInt64(&c.received)
+}
+
+// ServeMetrics serves the metrics on /metrics of address, for processes
+// that do not serve the HTTP API
+func ServeMetrics(address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return http.ListenAndServe(address, mux)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"dec/messages"
+)
+
+func TestMeterArrived(t *testing.T) {
+	m := NewMeter()
+	ca := &ClientActor{Client: &Client{Meter: m, Feed: NewFeed(), ElevatorStatusMap: &sync.Map{}}}
+	m.assign(0, 3, 1, time.Now())
+
+	// a car parked or passing by at the floor has not opened for the call
+	ca.storeStatus(&messages.StatusResponse{Id: 0, Floor: 3})
+	if len(m.waiting[0]) != 1 {
+		t.Error("Expected the call to wait, got ", m.waiting[0])
+	}
+
+	ca.arrived(&messages.ArrivalEvent{Id: 0, Floor: 3})
+	if len(m.waiting[0]) != 0 {
+		t.Error("Expected the call to be served, got ", m.waiting[0])
+	}
+}
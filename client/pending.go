@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"dec/messages"
+)
+
+// RefusedError is an elevator turning down a command
+type RefusedError struct {
+	Id     uint32
+	Reason string
+}
+
+func (e *RefusedError) Error() string {
+	return fmt.Sprintf("elevator %d refused: %s", e.Id, e.Reason)
+}
+
+// Pending matches the answers of the elevators to the requests waiting
+// for them by the id the elevators echo. A request given up on is
+// forgotten, its late answers are dropped rather than taken by the next
+// one.
+type Pending struct {
+	mu       sync.Mutex
+	last     uint64
+	requests map[uint64]*Request
+}
+
+// Request is a message sent to one or more elevators and the answers it
+// still expects
+type Request struct {
+	Id    uint64
+	count int
+	done  chan struct{}
+	err   error
+	// Arrivals reported by the answers to a multi step request
+	events []*messages.ArrivalEvent
+	// Cars that answered by id
+	answered map[uint32]bool
+}
+
+func NewPending() *Pending {
+	return &Pending{requests: make(map[uint64]*Request)}
+}
+
+// Expect starts a request awaiting n answers
+func (p *Pending) Expect(n int) *Request {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.last++
+	r := &Request{Id: p.last, count: n, done: make(chan struct{}), answered: make(map[uint32]bool)}
+	if n == 0 {
+		close(r.done)
+		return r
+	}
+	p.requests[r.Id] = r
+
+	return r
+}
+
+// Answer takes the answer of car to request id reporting err and the
+// arrivals on the way, if any. It reports false for answers nobody waits
+// for.
+func (p *Pending) Answer(id uint64, car uint32, err error, events ...*messages.ArrivalEvent) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.requests[id]
+	if !ok {
+		return false
+	}
+	if r.err == nil {
+		r.err = err
+	}
+	r.events = append(r.events, events...)
+	r.answered[car] = true
+	r.count--
+	if r.count == 0 {
+		delete(p.requests, id)
+		close(r.done)
+	}
+
+	return true
+}
+
+// Wait blocks until every answer to r came in or ctx is done, r is
+// given up on in the latter case. It returns the first error reported by
+// the answers.
+func (p *Pending) Wait(ctx context.Context, r *Request) error {
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		// Answered in the meantime
+		if _, ok := p.requests[r.Id]; !ok {
+			return r.err
+		}
+		delete(p.requests, r.Id)
+		return ctx.Err()
+	}
+
+	return r.err
+}
+
+// Forget gives up on r before it was sent
+func (p *Pending) Forget(r *Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.requests, r.Id)
+}
+
+// Answered reports whether car answered r, final once Wait returned
+func (r *Request) Answered(car uint32) bool {
+	return r.answered[car]
+}
+
+// Events are the arrivals reported by the answers to r, complete once
+// Wait returned
+func (r *Request) Events() []*messages.ArrivalEvent {
+	return r.events
+}
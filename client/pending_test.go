@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPending(t *testing.T) {
+	p := NewPending()
+
+	// given up on before its answer came in
+	abandoned := p.Expect(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.Wait(ctx, abandoned); err != context.Canceled {
+		t.Error("Expected ", context.Canceled, ", got ", err)
+	}
+
+	request := p.Expect(2)
+	if p.Answer(abandoned.Id, 0, &RefusedError{Id: 0, Reason: "late"}) {
+		t.Error("Expected the late answer to be dropped")
+	}
+	p.Answer(request.Id, 0, nil)
+	p.Answer(request.Id, 1, nil)
+	if err := p.Wait(context.Background(), request); err != nil {
+		t.Error("Expected no error, got ", err)
+	}
+	if !request.Answered(1) || abandoned.Answered(0) {
+		t.Error("Expected only the answers that came in to count")
+	}
+}
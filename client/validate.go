@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+
+	"dec/messages"
+)
+
+// ValidationError is an argument no elevator could act on
+type ValidationError struct {
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Reason
+}
+
+func (client *Client) ValidateFloor(floor uint32) error {
+	if floor >= messages.FLOORS {
+		return &ValidationError{fmt.Sprintf("floor %d out of range [0, %d)", floor, messages.FLOORS)}
+	}
+
+	return nil
+}
+
+// ValidateDestination checks both floors of a destination call, the
+// passenger must be going somewhere else
+func (client *Client) ValidateDestination(floor uint32, destination uint32) error {
+	if err := client.ValidateFloor(floor); err != nil {
+		return err
+	}
+	if err := client.ValidateFloor(destination); err != nil {
+		return err
+	}
+	if floor == destination {
+		return &ValidationError{fmt.Sprintf("destination %d is the floor of the call", destination)}
+	}
+
+	return nil
+}
+
+func (client *Client) ValidateId(id int) error {
+	if id < 0 || id >= client.ElevatorCount {
+		return &ValidationError{fmt.Sprintf("elevator %d out of range [0, %d)", id, client.ElevatorCount)}
+	}
+
+	return nil
+}
+
+func (client *Client) ValidateDirection(direction int32) error {
+	if direction != 1 && direction != -1 {
+		return &ValidationError{fmt.Sprintf("direction must be 1 or -1, got %d", direction)}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package control
+
+import (
+	"errors"
+
+	"dec/internal/auth"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// caller identifies the peer of ctx by the client certificate it
+// presented, the TLS handshake verified it against the CA already
+func caller(ctx context.Context) (string, auth.Role, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", auth.NONE, errors.New("unknown peer")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", auth.NONE, errors.New("no verified client certificate")
+	}
+
+	return auth.Identify(info.State.VerifiedChains[0][0])
+}
+
+// authorize fails unless the caller of ctx may run every action. The
+// dispatcher runs commands with its own principal, so the caller is
+// checked here before the elevators see them.
+func (s *Server) authorize(ctx context.Context, actions ...string) error {
+	if s.Insecure {
+		return nil
+	}
+
+	name, role, err := caller(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	policy := s.Policy
+	if policy == nil {
+		policy = auth.DefaultPolicy
+	}
+	for _, action := range actions {
+		if err := policy.Allow(name, role, action); err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package control
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"dec/internal/auth"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// presenting gives ctx a peer that presented a certificate for name in
+// unit
+func presenting(name string, unit string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: name, OrganizationalUnit: []string{unit}}}
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	s := &Server{}
+
+	if err := s.authorize(context.Background(), auth.ACTION_STATUS); status.Code(err) != codes.Unauthenticated {
+		t.Error("Expected callers without a certificate to be refused, got ", err)
+	}
+	if err := s.authorize(presenting("bob", "passenger"), auth.ACTION_PICKUP); err != nil {
+		t.Error("Expected passengers to pick up, got ", err)
+	}
+	// the role of the caller counts, not the one of the dispatcher
+	if err := s.authorize(presenting("bob", "passenger"), auth.ACTION_PICKUP, auth.ACTION_UPDATE); status.Code(err) != codes.PermissionDenied {
+		t.Error("Expected passengers to be denied car calls, got ", err)
+	}
+	if err := s.authorize(presenting("alice", "operator"), auth.ACTION_STEP); err != nil {
+		t.Error("Expected operators to step, got ", err)
+	}
+	if err := s.authorize(presenting("eve", "elevators"), auth.ACTION_STATUS); status.Code(err) != codes.Unauthenticated {
+		t.Error("Expected a certificate without a role to be refused, got ", err)
+	}
+
+	s.Insecure = true
+	if err := s.authorize(context.Background(), auth.ACTION_STEP); err != nil {
+		t.Error("Expected an insecure server to let anyone in, got ", err)
+	}
+}
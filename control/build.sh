@@ -0,0 +1,3 @@
+#!/bin/bash
+
+protoc -I=. -I=$GOPATH/src --gogoslick_out=plugins=grpc:. control.proto
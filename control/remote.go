@@ -0,0 +1,261 @@
+package control
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"dec/client"
+	"dec/messages"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Time a remote call may take before it is given up
+const REMOTE_TIMEOUT = 5 * time.Second
+
+// Remote drives a dispatcher service over gRPC. It keeps the statuses of
+// the last reply so tools can print them like they would with a Client.
+// Given the replicas of a dispatcher it follows the leader around.
+type Remote struct {
+	Controls []ControlClient
+	Statuses []*client.ElevatorStatus
+	current  int
+}
+
+func NewRemote(conns ...*grpc.ClientConn) *Remote {
+	controls := []ControlClient{}
+	for _, conn := range conns {
+		controls = append(controls, NewControlClient(conn))
+	}
+
+	return &Remote{
+		Controls: controls,
+		Statuses: []*client.ElevatorStatus{},
+	}
+}
+
+// call runs fn against the current dispatcher, moving on to the next
+// replica while they answer they can't take the call. Every attempt is
+// bounded by REMOTE_TIMEOUT within ctx.
+func (r *Remote) call(ctx context.Context, fn func(ctx context.Context, c ControlClient) error) error {
+	if len(r.Controls) == 0 {
+		return status.Error(codes.Unavailable, "no dispatchers")
+	}
+	ctx = withTrace(ctx)
+
+	var err error
+	for range r.Controls {
+		attempt, cancel := context.WithTimeout(ctx, REMOTE_TIMEOUT)
+		err = fn(attempt, r.Controls[r.current])
+		cancel()
+		if status.Code(err) != codes.Unavailable {
+			return fromRPC(err)
+		}
+		r.current = (r.current + 1) % len(r.Controls)
+	}
+
+	return err
+}
+
+// fromRPC turns the status of a failed call back into the errors of the
+// client where there is one
+func fromRPC(err error) error {
+	s, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+
+	switch s.Code() {
+	case codes.InvalidArgument:
+		return &client.ValidationError{Reason: s.Message()}
+	case codes.FailedPrecondition:
+		refused := &client.RefusedError{Reason: s.Message()}
+		if _, err := fmt.Sscanf(s.Message(), "elevator %d refused:", &refused.Id); err == nil {
+			refused.Reason = strings.TrimPrefix(s.Message(), fmt.Sprintf("elevator %d refused: ", refused.Id))
+		}
+		return refused
+	case codes.ResourceExhausted:
+		limited := &client.RateLimitedError{}
+		if _, err := fmt.Sscanf(s.Message(), "too many pickups at floor %d", &limited.Floor); err == nil {
+			return limited
+		}
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	case codes.Canceled:
+		return context.Canceled
+	}
+
+	return err
+}
+
+func (r *Remote) store(elevators []*ElevatorStatus) []*client.ElevatorStatus {
+	statuses := []*client.ElevatorStatus{}
+	for _, e := range elevators {
+		statuses = append(statuses, &client.ElevatorStatus{
+			Id:    e.Id,
+			Floor: e.Floor,
+			Goal:  e.Goal,
+			State: e.State,
+		})
+	}
+	r.Statuses = statuses
+
+	return statuses
+}
+
+// statusOf picks elevator id out of the statuses of the last reply
+func (r *Remote) statusOf(id int) *client.ElevatorStatus {
+	for _, s := range r.Statuses {
+		if int(s.Id) == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Status fetches every car
+func (r *Remote) Status(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	var res *StatusResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.GetStatus(ctx, &StatusRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store(res.Elevators), nil
+}
+
+func (r *Remote) Update(ctx context.Context, id int, goal uint32, state int32) (*client.ElevatorStatus, error) {
+	var res *StatusResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.CarCall(ctx, &CarCallRequest{Id: uint32(id), Goal: goal, Direction: state})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.store(res.Elevators)
+
+	return r.statusOf(id), nil
+}
+
+func (r *Remote) assignment(res *PickupResponse) *client.Assignment {
+	r.store(res.Elevators)
+	if res.Queued {
+		return &client.Assignment{Id: client.NOT_FOUND, Queued: true}
+	}
+
+	return &client.Assignment{Id: res.Id}
+}
+
+func (r *Remote) Pickup(ctx context.Context, floor uint32, state int32) (*client.Assignment, error) {
+	var res *PickupResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.Pickup(ctx, &PickupRequest{Floor: floor, Direction: state})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.assignment(res), nil
+}
+
+func (r *Remote) DestinationPickup(ctx context.Context, floor uint32, destination uint32) (*client.Assignment, error) {
+	var res *PickupResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.DestinationPickup(ctx, &DestinationPickupRequest{Floor: floor, Destination: destination})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.assignment(res), nil
+}
+
+func (r *Remote) Cancel(ctx context.Context, id int, floor uint32) (*client.ElevatorStatus, error) {
+	var res *StatusResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.Cancel(ctx, &CancelRequest{Id: uint32(id), Floor: floor})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.store(res.Elevators)
+
+	return r.statusOf(id), nil
+}
+
+func (r *Remote) Batch(ctx context.Context, id int, items []interface{}) (*client.ElevatorStatus, error) {
+	req := &BatchRequest{Id: uint32(id)}
+	for _, item := range items {
+		switch i := item.(type) {
+		case client.UpdateRequestItem:
+			req.Commands = append(req.Commands, &Command{Command: &Command_CarCall{
+				CarCall: &CarCallRequest{Id: uint32(id), Goal: i.Goal, Direction: i.State},
+			}})
+		case client.PickupRequestItem:
+			req.Commands = append(req.Commands, &Command{Command: &Command_Pickup{
+				Pickup: &PickupRequest{Floor: i.Floor, Direction: i.State},
+			}})
+		default:
+			return nil, &client.ValidationError{Reason: fmt.Sprintf("unsupported batch item %T", item)}
+		}
+	}
+
+	var res *StatusResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.Batch(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.store(res.Elevators)
+
+	return r.statusOf(id), nil
+}
+
+func (r *Remote) Step(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	if _, err := r.Steps(ctx, 1, false); err != nil {
+		return nil, err
+	}
+
+	return r.Statuses, nil
+}
+
+func (r *Remote) Steps(ctx context.Context, count uint32, untilIdle bool) ([]*messages.ArrivalEvent, error) {
+	var res *StepResponse
+	err := r.call(ctx, func(ctx context.Context, c ControlClient) (err error) {
+		res, err = c.Step(ctx, &StepRequest{Count: count, UntilIdle: untilIdle})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.store(res.Elevators)
+
+	events := []*messages.ArrivalEvent{}
+	for _, arrival := range res.Arrivals {
+		events = append(events, &messages.ArrivalEvent{
+			Id:    arrival.Id,
+			Floor: arrival.Floor,
+			State: arrival.State,
+		})
+	}
+
+	return events, nil
+}
+
+func (r *Remote) PrintCurrentStatus() {
+	client.PrintStatus(r.Statuses)
+}
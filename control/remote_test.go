@@ -0,0 +1,33 @@
+package control
+
+import (
+	"testing"
+
+	"dec/client"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoteWithoutDispatchers(t *testing.T) {
+	r := NewRemote()
+	if _, err := r.Status(context.Background()); status.Code(err) != codes.Unavailable {
+		t.Error("Expected a remote without dispatchers to fail, got ", err)
+	}
+}
+
+func TestFromRPC(t *testing.T) {
+	err := fromRPC(toRPC(&client.RefusedError{Id: 2, Reason: "faulty"}))
+	refused, ok := err.(*client.RefusedError)
+	if !ok {
+		t.Fatal("Expected a refusal, got ", err)
+	}
+	if refused.Id != 2 || refused.Reason != "faulty" {
+		t.Error("Expected elevator 2 to refuse as faulty, got ", refused)
+	}
+
+	if _, ok := fromRPC(toRPC(&client.ValidationError{Reason: "bad"})).(*client.ValidationError); !ok {
+		t.Error("Expected a validation error")
+	}
+}
@@ -0,0 +1,193 @@
+package control
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"dec/client"
+
+	"github.com/hashicorp/raft"
+	log "github.com/sirupsen/logrus"
+)
+
+// Time a ledger may take to reach a majority of the replicas
+const REPLICATE_TIMEOUT = 5 * time.Second
+
+// Replica keeps the ledger of a dispatcher in sync with its standbys
+// through raft. Only the leader talks to the elevators and it copies its
+// ledger to the others after every command. A standby that wins an
+// election restores the last ledger and carries on from there.
+type Replica struct {
+	Client *client.Client
+	Raft   *raft.Raft
+	fsm    *ledgerFSM
+	done   chan struct{}
+	// set once the ledger is restored, guarded by Client.Mu
+	leading bool
+}
+
+// ledgerFSM holds the last ledger committed by the leader
+type ledgerFSM struct {
+	mu     sync.Mutex
+	ledger *client.Ledger
+}
+
+func (f *ledgerFSM) Apply(l *raft.Log) interface{} {
+	ledger := &client.Ledger{}
+	if err := json.Unmarshal(l.Data, ledger); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.ledger = ledger
+	f.mu.Unlock()
+
+	return nil
+}
+
+func (f *ledgerFSM) Snapshot() (raft.FSMSnapshot, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data, err := json.Marshal(f.ledger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ledgerSnapshot{data: data}, nil
+}
+
+func (f *ledgerFSM) Restore(rc io.ReadCloser) error {
+	defer rc.Close()
+
+	ledger := &client.Ledger{}
+	if err := json.NewDecoder(rc).Decode(ledger); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.ledger = ledger
+	f.mu.Unlock()
+
+	return nil
+}
+
+func (f *ledgerFSM) last() *client.Ledger {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.ledger
+}
+
+type ledgerSnapshot struct {
+	data []byte
+}
+
+func (s *ledgerSnapshot) Persist(sink raft.SnapshotSink) error {
+	if _, err := sink.Write(s.data); err != nil {
+		sink.Cancel()
+		return err
+	}
+
+	return sink.Close()
+}
+
+func (s *ledgerSnapshot) Release() {}
+
+// NewReplica joins c to the replicas reachable over transport. The raft
+// log is kept in memory, a ledger survives as long as a majority of the
+// replicas is up.
+func NewReplica(c *client.Client, config *raft.Config, transport raft.Transport) (*Replica, error) {
+	fsm := &ledgerFSM{}
+	store := raft.NewInmemStore()
+
+	r, err := raft.NewRaft(config, fsm, store, store, raft.NewInmemSnapshotStore(), transport)
+	if err != nil {
+		return nil, err
+	}
+
+	replica := &Replica{
+		Client: c,
+		Raft:   r,
+		fsm:    fsm,
+		done:   make(chan struct{}),
+	}
+	go replica.watch()
+
+	return replica, nil
+}
+
+// Bootstrap forms a new cluster out of servers, every replica may be
+// bootstrapped with the same servers
+func (r *Replica) Bootstrap(servers []raft.Server) error {
+	return r.Raft.BootstrapCluster(raft.Configuration{Servers: servers}).Error()
+}
+
+// IsLeader reports whether this replica dispatches, callers must hold
+// Client.Mu
+func (r *Replica) IsLeader() bool {
+	return r.leading && r.Raft.State() == raft.Leader
+}
+
+// Leader returns the raft address of the current leader, if any
+func (r *Replica) Leader() string {
+	return string(r.Raft.Leader())
+}
+
+// Replicate commits the ledger of the client to a majority of the
+// replicas, callers must hold Client.Mu
+func (r *Replica) Replicate() error {
+	data, err := json.Marshal(r.Client.Snapshot())
+	if err != nil {
+		return err
+	}
+
+	return r.Raft.Apply(data, REPLICATE_TIMEOUT).Error()
+}
+
+// watch takes over the dispatch whenever this replica becomes leader
+func (r *Replica) watch() {
+	for {
+		select {
+		case leader := <-r.Raft.LeaderCh():
+			if leader {
+				r.takeOver()
+			} else {
+				r.Client.Mu.Lock()
+				r.leading = false
+				r.Client.Mu.Unlock()
+				log.Info("dispatcher stepped down")
+			}
+		case <-r.done:
+			return
+		}
+	}
+}
+
+func (r *Replica) takeOver() {
+	// Wait for the ledgers committed by the previous leader
+	if err := r.Raft.Barrier(REPLICATE_TIMEOUT).Error(); err != nil {
+		log.WithError(err).Error("takeover failed")
+		return
+	}
+
+	r.Client.Mu.Lock()
+	defer r.Client.Mu.Unlock()
+
+	if ledger := r.fsm.last(); ledger != nil {
+		r.Client.Restore(ledger)
+	}
+	// The cars kept moving while nobody was leading
+	r.Client.SendStatusRequest(client.StatusRequestOpt{BroadcastAll: true})
+	r.leading = true
+
+	log.WithField("queued", r.Client.PickupQueue.Len()).Info("dispatcher took over as leader")
+}
+
+func (r *Replica) Shutdown() error {
+	close(r.done)
+
+	return r.Raft.Shutdown().Error()
+}
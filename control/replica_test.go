@@ -0,0 +1,156 @@
+package control
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"dec/client"
+	"dec/internal/queue"
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/hashicorp/raft"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newLedgerClient builds a client without elevators, enough to hold
+// a ledger
+func newLedgerClient() *client.Client {
+	return &client.Client{
+		Mu:                     &sync.Mutex{},
+		Pending:                client.NewPending(),
+		ElevatorPidList:        &[]*actor.PID{},
+		ElevatorStatusMap:      &sync.Map{},
+		ClientActor:            &client.ClientActor{},
+		PickupQueue:            queue.New(),
+		DestinationAssignments: &sync.Map{},
+		Handoffs:               &sync.Map{},
+		ElevatorHelloMap:       &sync.Map{},
+		Feed:                   client.NewFeed(),
+	}
+}
+
+// newReplicas starts count replicas connected over in-memory transports
+func newReplicas(t *testing.T, count int) []*Replica {
+	transports := []*raft.InmemTransport{}
+	servers := []raft.Server{}
+	for i := 0; i < count; i++ {
+		addr, transport := raft.NewInmemTransport(raft.ServerAddress(fmt.Sprint("replica", i)))
+		transports = append(transports, transport)
+		servers = append(servers, raft.Server{ID: raft.ServerID(addr), Address: addr})
+	}
+	for _, a := range transports {
+		for _, b := range transports {
+			if a != b {
+				a.Connect(b.LocalAddr(), b)
+			}
+		}
+	}
+
+	replicas := []*Replica{}
+	for i, transport := range transports {
+		config := raft.DefaultConfig()
+		config.LocalID = servers[i].ID
+		config.HeartbeatTimeout = 50 * time.Millisecond
+		config.ElectionTimeout = 50 * time.Millisecond
+		config.LeaderLeaseTimeout = 50 * time.Millisecond
+		config.CommitTimeout = 5 * time.Millisecond
+		config.LogOutput = ioutil.Discard
+
+		replica, err := NewReplica(newLedgerClient(), config, transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := replica.Bootstrap(servers); err != nil {
+			t.Fatal(err)
+		}
+		replicas = append(replicas, replica)
+	}
+
+	return replicas
+}
+
+func isLeader(r *Replica) bool {
+	r.Client.Mu.Lock()
+	defer r.Client.Mu.Unlock()
+
+	return r.IsLeader()
+}
+
+// waitForLeader returns the replica that took over
+func waitForLeader(t *testing.T, replicas []*Replica) *Replica {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		for _, r := range replicas {
+			if isLeader(r) {
+				return r
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Expected a leader to be elected")
+
+	return nil
+}
+
+func TestReplicaFailover(t *testing.T) {
+	replicas := newReplicas(t, 3)
+	defer func() {
+		for _, r := range replicas {
+			r.Shutdown()
+		}
+	}()
+
+	leader := waitForLeader(t, replicas)
+
+	leader.Client.Mu.Lock()
+	leader.Client.PickupQueue.PushBack(client.PickupRequestItem{Floor: 3, State: 1})
+	leader.Client.DestinationAssignments.Store(uint32(7), &client.DestinationAssignment{Id: 1, State: 1})
+	leader.Client.Handoffs.Store(uint32(2), &messages.DrainEvent{Id: 2, HallCalls: []*messages.HallCall{{Floor: 5, State: -1}}})
+	err := leader.Replicate()
+	leader.Client.Mu.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// standbys refuse commands
+	standbys := []*Replica{}
+	for _, r := range replicas {
+		if r != leader {
+			standbys = append(standbys, r)
+		}
+	}
+	server := &Server{Client: standbys[0].Client, Replica: standbys[0], Insecure: true}
+	if _, err := server.GetStatus(context.Background(), &StatusRequest{}); status.Code(err) != codes.Unavailable {
+		t.Error("Expected a standby to refuse the call, got ", err)
+	}
+	// a command that ran is not handed to the next replica
+	if err := server.replicate(); status.Code(err) != codes.Aborted {
+		t.Error("Expected a failed replication to abort, got ", err)
+	}
+
+	leader.Shutdown()
+	replicas = standbys
+
+	next := waitForLeader(t, standbys)
+
+	next.Client.Mu.Lock()
+	defer next.Client.Mu.Unlock()
+
+	if next.Client.PickupQueue.Len() != 1 {
+		t.Fatal("Expected the queued pickup to survive, got ", next.Client.PickupQueue.Len())
+	}
+	if item := next.Client.PickupQueue.Front().(client.PickupRequestItem); item.Floor != 3 || item.State != 1 {
+		t.Error("Expected pickup at floor 3 going up, got ", item)
+	}
+	if v, ok := next.Client.DestinationAssignments.Load(uint32(7)); !ok || v.(*client.DestinationAssignment).Id != 1 {
+		t.Error("Expected elevator 1 to keep the group for floor 7, got ", v)
+	}
+	if v, ok := next.Client.Handoffs.Load(uint32(2)); !ok || v.(*messages.DrainEvent).HallCalls[0].Floor != 5 {
+		t.Error("Expected the hall call handed off by elevator 2 to survive, got ", v)
+	}
+}
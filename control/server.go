@@ -0,0 +1,355 @@
+package control
+
+import (
+	"dec/client"
+	"dec/internal/auth"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Events buffered per watcher before the feed starts dropping
+const WATCH_BUFFER = 256
+
+// Server implements ControlServer on top of a shared client
+type Server struct {
+	Client *client.Client
+	// Replica is nil when the dispatcher runs alone
+	Replica *Replica
+	// Policy callers are held to, DefaultPolicy when nil
+	Policy auth.Policy
+	// Insecure serves every caller without asking for a certificate
+	Insecure bool
+}
+
+func NewServer(c *client.Client) *Server {
+	return &Server{Client: c}
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// toRPC gives an error of the client the status code callers expect,
+// errors carrying a status already keep it
+func toRPC(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch err.(type) {
+	case *client.ValidationError:
+		return invalidArgument(err)
+	case *client.RefusedError:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case *client.RateLimitedError:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	switch err {
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// lead refuses the call on standby replicas, callers must hold Client.Mu
+func (s *Server) lead() error {
+	if s.Replica == nil || s.Replica.IsLeader() {
+		return nil
+	}
+
+	return status.Errorf(codes.Unavailable, "not the leader, the leader is %q", s.Replica.Leader())
+}
+
+// replicate hands the ledger to the standbys once a command ran,
+// callers must hold Client.Mu. Failures are Aborted rather than
+// Unavailable, the command ran already and must not be retried on the
+// next replica.
+func (s *Server) replicate() error {
+	if s.Replica == nil {
+		return nil
+	}
+	if err := s.Replica.Replicate(); err != nil {
+		return status.Error(codes.Aborted, "command ran but replication failed: "+err.Error())
+	}
+
+	return nil
+}
+
+// Run is the one path the commands of every transport take to the
+// client. It refuses callers that may not run actions, holds Client.Mu,
+// refuses the command on standby replicas and hands the ledger to the
+// standbys once fn ran. Commands that failed
+// are replicated as well, the cars may have moved before the failure.
+// Errors of fn are returned as they are.
+func (s *Server) Run(ctx context.Context, fn func(ctx context.Context) error, actions ...string) error {
+	if err := s.authorize(ctx, actions...); err != nil {
+		return err
+	}
+
+	s.Client.Mu.Lock()
+	defer s.Client.Mu.Unlock()
+
+	if err := s.lead(); err != nil {
+		return err
+	}
+
+	err := fn(ctx)
+	if writes(actions) {
+		if err := s.replicate(); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// writes reports whether actions change the dispatch state
+func writes(actions []string) bool {
+	for _, action := range actions {
+		if action != auth.ACTION_STATUS {
+			return true
+		}
+	}
+
+	return false
+}
+
+// command runs fn and answers with the statuses it left behind
+func (s *Server) command(ctx context.Context, fn func(ctx context.Context) error, actions ...string) (*StatusResponse, error) {
+	var res *StatusResponse
+	err := s.Run(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		res = &StatusResponse{Elevators: s.elevators()}
+		return nil
+	}, actions...)
+	if err != nil {
+		return nil, toRPC(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) elevators() []*ElevatorStatus {
+	elevators := []*ElevatorStatus{}
+	for _, e := range s.Client.CurrentStatus() {
+		elevators = append(elevators, &ElevatorStatus{
+			Id:    e.Id,
+			Floor: e.Floor,
+			Goal:  e.Goal,
+			State: e.State,
+		})
+	}
+
+	return elevators
+}
+
+func (s *Server) GetStatus(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
+
+	var res *StatusResponse
+	err := s.Run(ctx, func(ctx context.Context) error {
+		if _, err := s.Client.Status(ctx); err != nil {
+			return err
+		}
+		res = &StatusResponse{Elevators: s.elevators()}
+		return nil
+	}, auth.ACTION_STATUS)
+	if err != nil {
+		return nil, toRPC(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) Pickup(ctx context.Context, req *PickupRequest) (*PickupResponse, error) {
+	if err := s.Client.ValidateFloor(req.Floor); err != nil {
+		return nil, invalidArgument(err)
+	}
+	if err := s.Client.ValidateDirection(req.Direction); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var res *PickupResponse
+	err := s.Run(ctx, func(ctx context.Context) error {
+		a, err := s.Client.Pickup(ctx, req.Floor, req.Direction)
+		if err != nil {
+			return err
+		}
+		res = &PickupResponse{Elevators: s.elevators(), Queued: a.Queued}
+		if !a.Queued {
+			res.Id = a.Id
+		}
+		return nil
+	}, auth.ACTION_PICKUP)
+	if err != nil {
+		return nil, toRPC(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) DestinationPickup(ctx context.Context, req *DestinationPickupRequest) (*PickupResponse, error) {
+	if err := s.Client.ValidateDestination(req.Floor, req.Destination); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var res *PickupResponse
+	err := s.Run(ctx, func(ctx context.Context) error {
+		a, err := s.Client.DestinationPickup(ctx, req.Floor, req.Destination)
+		if err != nil {
+			return err
+		}
+		res = &PickupResponse{Elevators: s.elevators(), Queued: a.Queued}
+		if !a.Queued {
+			res.Id = a.Id
+		}
+		return nil
+	}, auth.ACTION_DESTINATION)
+	if err != nil {
+		return nil, toRPC(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) CarCall(ctx context.Context, req *CarCallRequest) (*StatusResponse, error) {
+	if err := s.Client.ValidateId(int(req.Id)); err != nil {
+		return nil, invalidArgument(err)
+	}
+	if err := s.Client.ValidateFloor(req.Goal); err != nil {
+		return nil, invalidArgument(err)
+	}
+	if err := s.Client.ValidateDirection(req.Direction); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Update(ctx, int(req.Id), req.Goal, req.Direction)
+		return err
+	}, auth.ACTION_UPDATE)
+}
+
+func (s *Server) Step(ctx context.Context, req *StepRequest) (*StepResponse, error) {
+
+	var res *StepResponse
+	err := s.Run(ctx, func(ctx context.Context) error {
+		if req.Count <= 1 && !req.UntilIdle {
+			if _, err := s.Client.Step(ctx); err != nil {
+				return err
+			}
+			res = &StepResponse{Elevators: s.elevators()}
+			return nil
+		}
+
+		events, err := s.Client.Steps(ctx, req.Count, req.UntilIdle)
+		if err != nil {
+			return err
+		}
+		res = &StepResponse{Elevators: s.elevators()}
+		for _, event := range events {
+			res.Arrivals = append(res.Arrivals, &Arrival{
+				Id:    event.Id,
+				Floor: event.Floor,
+				State: event.State,
+			})
+		}
+		return nil
+	}, auth.ACTION_STEP)
+	if err != nil {
+		return nil, toRPC(err)
+	}
+
+	return res, nil
+}
+
+func (s *Server) Cancel(ctx context.Context, req *CancelRequest) (*StatusResponse, error) {
+	if err := s.Client.ValidateId(int(req.Id)); err != nil {
+		return nil, invalidArgument(err)
+	}
+	if err := s.Client.ValidateFloor(req.Floor); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Cancel(ctx, int(req.Id), req.Floor)
+		return err
+	}, auth.ACTION_CANCEL)
+}
+
+func (s *Server) Batch(ctx context.Context, req *BatchRequest) (*StatusResponse, error) {
+	if err := s.Client.ValidateId(int(req.Id)); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	items := make([]interface{}, 0, len(req.Commands))
+	actions := []string{}
+	for _, command := range req.Commands {
+		var floor uint32
+		var direction int32
+		switch c := command.Command.(type) {
+		case *Command_CarCall:
+			floor, direction = c.CarCall.Goal, c.CarCall.Direction
+			items = append(items, client.UpdateRequestItem{Goal: floor, State: direction})
+			actions = append(actions, auth.ACTION_UPDATE)
+		case *Command_Pickup:
+			floor, direction = c.Pickup.Floor, c.Pickup.Direction
+			items = append(items, client.PickupRequestItem{Floor: floor, State: direction})
+			actions = append(actions, auth.ACTION_PICKUP)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "empty command")
+		}
+
+		if err := s.Client.ValidateFloor(floor); err != nil {
+			return nil, invalidArgument(err)
+		}
+		if err := s.Client.ValidateDirection(direction); err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+
+	return s.command(ctx, func(ctx context.Context) error {
+		_, err := s.Client.Batch(ctx, int(req.Id), items)
+		return err
+	}, actions...)
+}
+
+// WatchStatus streams the client feed until the caller goes away
+func (s *Server) WatchStatus(req *WatchRequest, stream Control_WatchStatusServer) error {
+	if err := s.Run(stream.Context(), func(context.Context) error { return nil }, auth.ACTION_STATUS); err != nil {
+		return err
+	}
+
+	filter := client.NewEventFilter(req.Ids, req.Types)
+
+	ch := s.Client.Feed.Subscribe(WATCH_BUFFER)
+	defer s.Client.Feed.Unsubscribe(ch)
+
+	for {
+		select {
+		case e := <-ch:
+			if !filter.Match(e) {
+				continue
+			}
+			err := stream.Send(&Event{
+				Type:  e.Type,
+				Time:  e.Time.UnixNano(),
+				Id:    e.Id,
+				Floor: e.Floor,
+				Goal:  e.Goal,
+				State: e.State,
+				Error: e.Error,
+			})
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
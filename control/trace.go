@@ -0,0 +1,39 @@
+package control
+
+import (
+	"dec/internal/tracing"
+
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata key the trace context of a call travels under
+const TRACE_METADATA = "traceparent"
+
+// withTrace hands the span in ctx on to the dispatcher
+func withTrace(ctx context.Context) context.Context {
+	traceparent := tracing.Inject(ctx)
+	if traceparent == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, TRACE_METADATA, traceparent)
+}
+
+// TraceInterceptor runs every call in a span continuing the trace of the
+// caller, so the dispatch decisions show up under the thin client
+func TraceInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(TRACE_METADATA); len(values) > 0 {
+			ctx = tracing.Extract(ctx, values[0])
+		}
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+	res, err := handler(ctx, req)
+	tracing.End(span, err)
+
+	return res, err
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"dec/client"
+	"dec/client/api"
+	"dec/control"
+	"dec/internal/auth"
+	"dec/internal/logging"
+	"dec/internal/mtls"
+	"dec/internal/tracing"
+
+	"github.com/hashicorp/raft"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var flagBind = flag.String("bind", "127.0.0.1:8997", "Bind to address")
+var flagCert = flag.String("cert", "", "TLS certificate presented to peers")
+var flagKey = flag.String("key", "", "TLS key of the certificate")
+var flagCA = flag.String("ca", "", "CA the certificates of peers must be issued by")
+var flagCredentials = flag.String("credentials", "", "Credentials issued by the authority signing the commands of this client")
+var flagElevators = flag.Int("elevators", 16, "Amount of elevators to connect to")
+var flagHosts = client.Hosts{}
+var flagGRPC = flag.String("grpc", "127.0.0.1:7000", "Serve the gRPC control service on address")
+var flagInsecure = flag.Bool("insecure", false, "Serve gRPC in plain text and let every caller run any command when no TLS is set up")
+var flagHTTP = flag.String("http", "", "Also serve the HTTP API on address")
+var flagMetrics = flag.String("metrics", "", "Serve Prometheus metrics on address, they are also served by --http")
+var flagRaft = flag.String("raft", "", "Replicate the ledger with the other dispatchers over raft on address")
+var flagPeers = flag.String("peers", "", "Raft addresses of every replica, including this one, separated by commas")
+var flagPickupRate = flag.Float64("pickup-rate", 0, "Pickups admitted per floor and second, unlimited when 0")
+var flagPickupBurst = flag.Int("pickup-burst", 5, "Pickups admitted at once per floor on top of --pickup-rate")
+var flagLogLevel = flag.String("log-level", "info", "Log entries from this level up: debug, info, warn or error")
+var flagLogFormat = flag.String("log-format", "text", "Log as text or json")
+var flagTrace = flag.String("trace", "", "Export spans to stdout or append them to this file, off when empty")
+var flagDestinationDispatch = flag.Bool("destination-dispatch", false, "Group passengers going to the same floor into the same car")
+
+func main() {
+	flag.Var(flagHosts, "host", "Address of a service hosting several elevators as address=ids, e.g. 127.0.0.1:9000=0-7, may be repeated")
+	flag.Parse()
+	if err := logging.Setup(*flagLogLevel, *flagLogFormat); err != nil {
+		log.WithError(err).Fatal("logging setup failed")
+	}
+
+	options, err := mtls.RemotingOptions(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+
+	credentials, err := auth.LoadCredentials(*flagCredentials)
+	if err != nil {
+		log.WithError(err).Fatal("auth setup failed")
+	}
+
+	stopTracing, err := tracing.Setup("dispatcher", *flagTrace)
+	if err != nil {
+		log.WithError(err).Fatal("tracing setup failed")
+	}
+	defer stopTracing()
+
+	c := client.NewClient(*flagBind, *flagElevators, options...)
+	c.Credentials = credentials
+	if err := c.Locate(flagHosts); err != nil {
+		log.WithError(err).Fatal("invalid hosts")
+	}
+	c.DestinationDispatch = *flagDestinationDispatch
+	c.Limiter = client.NewLimiter(*flagPickupRate, *flagPickupBurst)
+	if err := c.SendHelloRequest(); err != nil {
+		log.WithError(err).Fatal("handshake failed")
+	}
+	c.SendStatusRequest(client.StatusRequestOpt{BroadcastAll: true})
+
+	lis, err := net.Listen("tcp", *flagGRPC)
+	if err != nil {
+		log.WithError(err).Fatal("failed to listen")
+	}
+
+	// thin clients are held to the same CA as the elevators
+	creds, err := mtls.Credentials(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+	if creds == nil && !*flagInsecure {
+		log.Fatal("grpc needs --cert, --key and --ca to authorize callers, pass --insecure to serve plain text")
+	}
+	serverOptions := []grpc.ServerOption{grpc.UnaryInterceptor(control.TraceInterceptor)}
+	if creds != nil {
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+
+	if *flagMetrics != "" {
+		go func() {
+			log.WithError(client.ServeMetrics(*flagMetrics)).Error("metrics server stopped")
+		}()
+	}
+
+	s := control.NewServer(c)
+	s.Insecure = creds == nil
+	if *flagRaft != "" {
+		s.Replica = startReplica(c)
+	}
+
+	// the http api takes the path of grpc commands to the client and is
+	// held to the same certificates
+	if *flagHTTP != "" {
+		var config *tls.Config
+		if creds != nil {
+			if config, err = mtls.Load(*flagCert, *flagKey, *flagCA); err != nil {
+				log.WithError(err).Fatal("tls setup failed")
+			}
+		}
+		h := api.NewServer(c)
+		h.Guard = s.Run
+		go func() {
+			log.WithError(api.Serve(*flagHTTP, h, config)).Error("http api stopped")
+		}()
+	}
+
+	server := grpc.NewServer(serverOptions...)
+	control.RegisterControlServer(server, s)
+
+	log.WithField("address", *flagGRPC).Info("dispatcher serving grpc")
+	log.WithError(server.Serve(lis)).Fatal("grpc server stopped")
+}
+
+// startReplica joins the dispatchers listed in --peers, replicas are named
+// after their raft address
+func startReplica(c *client.Client) *control.Replica {
+	transport, err := raft.NewTCPTransport(*flagRaft, nil, 3, 10*time.Second, os.Stderr)
+	if err != nil {
+		log.WithError(err).Fatal("raft setup failed")
+	}
+
+	config := raft.DefaultConfig()
+	config.LocalID = raft.ServerID(*flagRaft)
+
+	replica, err := control.NewReplica(c, config, transport)
+	if err != nil {
+		log.WithError(err).Fatal("raft setup failed")
+	}
+
+	peers := *flagRaft
+	if *flagPeers != "" {
+		peers = *flagPeers
+	}
+	servers := []raft.Server{}
+	for _, peer := range strings.Split(peers, ",") {
+		servers = append(servers, raft.Server{
+			ID:      raft.ServerID(peer),
+			Address: raft.ServerAddress(peer),
+		})
+	}
+	if err := replica.Bootstrap(servers); err != nil {
+		log.WithError(err).Fatal("raft bootstrap failed")
+	}
+
+	log.WithField("address", *flagRaft).Info("dispatcher replicating")
+
+	return replica
+}
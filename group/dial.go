@@ -0,0 +1,112 @@
+package group
+
+import (
+	"context"
+	"sync"
+
+	"dec/client"
+	"dec/control"
+	"dec/messages"
+
+	"google.golang.org/grpc"
+)
+
+// dispatcher drives the elevators through the dispatcher service, one
+// call at a time as the remote follows the leader around
+type dispatcher struct {
+	mu     sync.Mutex
+	remote *control.Remote
+	conns  []*grpc.ClientConn
+}
+
+// Dial drives the elevators through the dispatcher service at addresses,
+// the addresses of every replica when it is replicated
+func Dial(addresses []string, options ...grpc.DialOption) (Group, error) {
+	conns := []*grpc.ClientConn{}
+	for _, address := range addresses {
+		conn, err := grpc.Dial(address, options...)
+		if err != nil {
+			for _, c := range conns {
+				c.Close()
+			}
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+
+	return &dispatcher{remote: control.NewRemote(conns...), conns: conns}, nil
+}
+
+func (d *dispatcher) Status(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Status(ctx)
+}
+
+func (d *dispatcher) CurrentStatus() []*client.ElevatorStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Statuses
+}
+
+func (d *dispatcher) Update(ctx context.Context, id int, goal uint32, direction int32) (*client.ElevatorStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Update(ctx, id, goal, direction)
+}
+
+func (d *dispatcher) Pickup(ctx context.Context, floor uint32, direction int32) (*client.Assignment, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Pickup(ctx, floor, direction)
+}
+
+func (d *dispatcher) DestinationPickup(ctx context.Context, floor uint32, destination uint32) (*client.Assignment, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.DestinationPickup(ctx, floor, destination)
+}
+
+func (d *dispatcher) Cancel(ctx context.Context, id int, floor uint32) (*client.ElevatorStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Cancel(ctx, id, floor)
+}
+
+func (d *dispatcher) Batch(ctx context.Context, id int, items []interface{}) (*client.ElevatorStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Batch(ctx, id, items)
+}
+
+func (d *dispatcher) Step(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Step(ctx)
+}
+
+func (d *dispatcher) Steps(ctx context.Context, count uint32, untilIdle bool) ([]*messages.ArrivalEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.remote.Steps(ctx, count, untilIdle)
+}
+
+func (d *dispatcher) Close() error {
+	var err error
+	for _, conn := range d.conns {
+		if e := conn.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,76 @@
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dec/client"
+)
+
+func TestEmbeddedClient(t *testing.T) {
+	t.Parallel()
+	c, err := NewEmbeddedClient(4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if err := c.SendHelloRequest(); err != nil {
+		t.Fatal(err)
+	}
+	c.SendStatusRequest(client.StatusRequestOpt{BroadcastAll: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.HELLO_TIMEOUT)
+	defer cancel()
+	if _, err := c.Pickup(ctx, 3, -1); err != nil {
+		t.Fatal(err)
+	}
+	events, err := c.Steps(ctx, 16, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Floor != 3 {
+		t.Error("Expected an arrival at floor 3, got ", events)
+	}
+}
+
+func TestEmbeddedEvents(t *testing.T) {
+	t.Parallel()
+	c, err := NewEmbeddedClient(1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	assigned := make(chan *client.Event, 1)
+	arrived := make(chan *client.Event, 1)
+	idle := make(chan *client.Event, 1)
+	defer c.Feed.OnAssigned(func(e *client.Event) { assigned <- e })()
+	defer c.Feed.OnArrived(func(e *client.Event) { arrived <- e })()
+	defer c.Feed.OnIdle(func(e *client.Event) { idle <- e })()
+
+	c.SendStatusRequest(client.StatusRequestOpt{BroadcastAll: true})
+	ctx, cancel := context.WithTimeout(context.Background(), client.HELLO_TIMEOUT)
+	defer cancel()
+	if _, err := c.Pickup(ctx, 3, -1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Steps(ctx, 16, true); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []struct {
+		name string
+		ch   chan *client.Event
+	}{{client.EVENT_ASSIGNED, assigned}, {client.EVENT_ARRIVED, arrived}, {client.EVENT_IDLE, idle}} {
+		select {
+		case e := <-expected.ch:
+			if e.Type != expected.name || e.Floor != 3 {
+				t.Error("Expected ", expected.name, " at floor 3, got ", e)
+			}
+		case <-time.After(time.Second):
+			t.Error("Expected ", expected.name, ", got nothing")
+		}
+	}
+}
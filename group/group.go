@@ -0,0 +1,46 @@
+// Package group is the Go API of an elevator group. Every operation takes
+// a context bounding how long it waits on the elevators and reports what
+// went wrong as an error: a *client.ValidationError for arguments no car
+// could act on, a *client.RefusedError when an elevator turned the command
+// down, a *client.RateLimitedError for pickups coming in faster than their
+// floor allows, or the error of the context when it ran out first.
+//
+// The group is either driven from this process, see Local, Embed and
+// Connect, or through the dispatcher service, see Dial.
+package group
+
+import (
+	"context"
+
+	"dec/client"
+	"dec/messages"
+)
+
+// Group is safe for concurrent use
+type Group interface {
+	// Status asks every elevator for its status
+	Status(ctx context.Context) ([]*client.ElevatorStatus, error)
+	// CurrentStatus is the status of every elevator as of the last call,
+	// without asking them
+	CurrentStatus() []*client.ElevatorStatus
+	// Update sends car id to goal going direction
+	Update(ctx context.Context, id int, goal uint32, direction int32) (*client.ElevatorStatus, error)
+	// Pickup hands a hall call to the best car, or queues it until a
+	// later step when none is free
+	Pickup(ctx context.Context, floor uint32, direction int32) (*client.Assignment, error)
+	// DestinationPickup hands a passenger going from floor to
+	// destination to a car
+	DestinationPickup(ctx context.Context, floor uint32, destination uint32) (*client.Assignment, error)
+	// Cancel drops the calls for floor on car id
+	Cancel(ctx context.Context, id int, floor uint32) (*client.ElevatorStatus, error)
+	// Batch applies client.UpdateRequestItem and client.PickupRequestItem
+	// to car id in one round trip
+	Batch(ctx context.Context, id int, items []interface{}) (*client.ElevatorStatus, error)
+	// Step advances every car one floor
+	Step(ctx context.Context) ([]*client.ElevatorStatus, error)
+	// Steps advances every car count steps, or until idle, and returns
+	// the arrivals on the way
+	Steps(ctx context.Context, count uint32, untilIdle bool) ([]*messages.ArrivalEvent, error)
+	// Close lets go of the elevators
+	Close() error
+}
@@ -0,0 +1,54 @@
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dec/client"
+)
+
+func TestEmbed(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	g, err := Embed(ctx, 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	if _, err := g.Status(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := g.Pickup(ctx, 16, 1); err == nil {
+		t.Error("Expected an error for floor 16")
+	} else if _, ok := err.(*client.ValidationError); !ok {
+		t.Error("Expected a validation error, got ", err)
+	}
+
+	a, err := g.Pickup(ctx, 5, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Queued || a.Id == client.NOT_FOUND {
+		t.Error("Expected the pickup to be assigned, got ", a)
+	}
+
+	events, err := g.Steps(ctx, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Id != a.Id || events[0].Floor != 5 {
+		t.Error("Expected car ", a.Id, " to arrive at floor 5, got ", events)
+	}
+
+	// a context already done gives up without waiting
+	done, stop := context.WithCancel(context.Background())
+	stop()
+	if _, err := g.Step(done); err != context.Canceled {
+		t.Error("Expected context.Canceled, got ", err)
+	}
+}
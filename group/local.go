@@ -0,0 +1,144 @@
+package group
+
+import (
+	"context"
+
+	"dec/client"
+	"dec/messages"
+	"dec/service/elevator"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// local drives the elevators from a client of this process, holding its
+// Mu for every call so the client may be shared with the HTTP API
+type local struct {
+	client *client.Client
+}
+
+// Local drives the elevators through c, which must have said hello
+func Local(c *client.Client) Group {
+	return &local{client: c}
+}
+
+// NewEmbeddedClient runs a whole group of elevators as actors of this
+// process next to the client. Remoting is not started and nothing
+// listens on the network, which suits services embedding the group as a
+// library and tests. The elevators get names of their own, so groups may
+// run side by side. persistence may be nil to keep the cars in memory.
+// Close stops the elevators again.
+func NewEmbeddedClient(count int, persistence elevator.Persistence) (*client.Client, error) {
+	ids := make([]uint, count)
+	for i := range ids {
+		ids[i] = uint(i)
+	}
+	services, err := elevator.SpawnEmbedded(ids, persistence)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make([]*actor.PID, count)
+	for i, s := range services {
+		pids[i] = s.PID
+	}
+
+	c := client.NewClientFor(pids)
+	c.Embedded = services
+
+	return c, nil
+}
+
+// Embed runs count elevators inside this process, see NewEmbeddedClient
+func Embed(ctx context.Context, count int, persistence elevator.Persistence) (Group, error) {
+	c, err := NewEmbeddedClient(count, persistence)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Hello(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return Local(c), nil
+}
+
+// Connect starts remoting on bind and drives count elevator services,
+// found on port 9000+id unless hosts says otherwise
+func Connect(ctx context.Context, bind string, count int, hosts client.Hosts, options ...remote.RemotingOption) (Group, error) {
+	c := client.NewClient(bind, count, options...)
+	if err := c.Locate(hosts); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if err := c.Hello(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return Local(c), nil
+}
+
+func (l *local) Status(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Status(ctx)
+}
+
+func (l *local) CurrentStatus() []*client.ElevatorStatus {
+	return l.client.CurrentStatus()
+}
+
+func (l *local) Update(ctx context.Context, id int, goal uint32, direction int32) (*client.ElevatorStatus, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Update(ctx, id, goal, direction)
+}
+
+func (l *local) Pickup(ctx context.Context, floor uint32, direction int32) (*client.Assignment, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Pickup(ctx, floor, direction)
+}
+
+func (l *local) DestinationPickup(ctx context.Context, floor uint32, destination uint32) (*client.Assignment, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.DestinationPickup(ctx, floor, destination)
+}
+
+func (l *local) Cancel(ctx context.Context, id int, floor uint32) (*client.ElevatorStatus, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Cancel(ctx, id, floor)
+}
+
+func (l *local) Batch(ctx context.Context, id int, items []interface{}) (*client.ElevatorStatus, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Batch(ctx, id, items)
+}
+
+func (l *local) Step(ctx context.Context) ([]*client.ElevatorStatus, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Step(ctx)
+}
+
+func (l *local) Steps(ctx context.Context, count uint32, untilIdle bool) ([]*messages.ArrivalEvent, error) {
+	l.client.Mu.Lock()
+	defer l.client.Mu.Unlock()
+
+	return l.client.Steps(ctx, count, untilIdle)
+}
+
+func (l *local) Close() error {
+	return l.client.Close()
+}
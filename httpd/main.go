@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+
+	"dec/client"
+	"dec/client/api"
+	"dec/internal/auth"
+	"dec/internal/logging"
+	"dec/internal/mtls"
+	"dec/internal/tracing"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var flagBind = flag.String("bind", "127.0.0.1:8998", "Bind to address")
+var flagCert = flag.String("cert", "", "TLS certificate presented to peers")
+var flagKey = flag.String("key", "", "TLS key of the certificate")
+var flagCA = flag.String("ca", "", "CA the certificates of peers must be issued by")
+var flagCredentials = flag.String("credentials", "", "Credentials issued by the authority signing the commands of this client")
+var flagElevators = flag.Int("elevators", 16, "Amount of elevators to connect to")
+var flagHosts = client.Hosts{}
+var flagPickupRate = flag.Float64("pickup-rate", 0, "Pickups admitted per floor and second, unlimited when 0")
+var flagPickupBurst = flag.Int("pickup-burst", 5, "Pickups admitted at once per floor on top of --pickup-rate")
+var flagLogLevel = flag.String("log-level", "info", "Log entries from this level up: debug, info, warn or error")
+var flagLogFormat = flag.String("log-format", "text", "Log as text or json")
+var flagTrace = flag.String("trace", "", "Export spans to stdout or append them to this file, off when empty")
+var flagHTTP = flag.String("http", "127.0.0.1:8080", "Serve the HTTP API on address")
+
+func main() {
+	flag.Var(flagHosts, "host", "Address of a service hosting several elevators as address=ids, e.g. 127.0.0.1:9000=0-7, may be repeated")
+	flag.Parse()
+	if err := logging.Setup(*flagLogLevel, *flagLogFormat); err != nil {
+		log.WithError(err).Fatal("logging setup failed")
+	}
+
+	options, err := mtls.RemotingOptions(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+
+	credentials, err := auth.LoadCredentials(*flagCredentials)
+	if err != nil {
+		log.WithError(err).Fatal("auth setup failed")
+	}
+
+	stopTracing, err := tracing.Setup("httpd", *flagTrace)
+	if err != nil {
+		log.WithError(err).Fatal("tracing setup failed")
+	}
+	defer stopTracing()
+
+	c := client.NewClient(*flagBind, *flagElevators, options...)
+	c.Credentials = credentials
+	if err := c.Locate(flagHosts); err != nil {
+		log.WithError(err).Fatal("invalid hosts")
+	}
+	c.Limiter = client.NewLimiter(*flagPickupRate, *flagPickupBurst)
+	if err := c.SendHelloRequest(); err != nil {
+		log.WithError(err).Fatal("handshake failed")
+	}
+	c.SendStatusRequest(client.StatusRequestOpt{BroadcastAll: true})
+
+	log.WithError(api.ListenAndServe(*flagHTTP, c)).Fatal("http api stopped")
+}
@@ -0,0 +1,123 @@
+// Package auth checks the principal carried by every command and decides
+// whether its role may run the command. Principals are tokens issued by
+// an authority for a name, a role and the key of their holder, and
+// expire. The holder signs every request it sends with its key, so a
+// token can't be used by anyone else, for another request or twice.
+// Elevators only hold the public key of the authority.
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+type Role int
+
+const (
+	NONE Role = iota
+	PASSENGER
+	OPERATOR
+	ADMIN
+)
+
+var roleNames = map[string]Role{
+	"passenger": PASSENGER,
+	"operator":  OPERATOR,
+	"admin":     ADMIN,
+}
+
+func (r Role) String() string {
+	for name, role := range roleNames {
+		if role == r {
+			return name
+		}
+	}
+
+	return "none"
+}
+
+func ParseRole(name string) (Role, error) {
+	role, ok := roleNames[name]
+	if !ok {
+		return NONE, fmt.Errorf("unknown role %q", name)
+	}
+
+	return role, nil
+}
+
+// Actions guarded by the policy
+const (
+	ACTION_PICKUP      = "pickup"
+	ACTION_DESTINATION = "destination"
+	ACTION_UPDATE      = "update"
+	ACTION_CANCEL      = "cancel"
+	ACTION_STEP        = "step"
+	ACTION_STATUS      = "status"
+)
+
+// Policy maps an action to the lowest role allowed to run it,
+// roles include every permission of the roles below them
+type Policy map[string]Role
+
+// DefaultPolicy lets passengers read the status and call cars with
+// pickups and destinations. Car calls, cancels and steps are left to
+// operators, actions it does not list are for admins only.
+var DefaultPolicy = Policy{
+	ACTION_PICKUP:      PASSENGER,
+	ACTION_DESTINATION: PASSENGER,
+	ACTION_UPDATE:      OPERATOR,
+	ACTION_CANCEL:      OPERATOR,
+	ACTION_STEP:        OPERATOR,
+	ACTION_STATUS:      PASSENGER,
+}
+
+// Authorize fails unless command carries a principal issued by
+// authority, proven by its holder for this very command and not seen
+// before by replays, whose role may run every action
+func (policy Policy) Authorize(authority ed25519.PublicKey, replays *Replays, command Command, actions ...string) error {
+	now := time.Now()
+	role, err := Verify(authority, command, now)
+	if err != nil {
+		return fmt.Errorf("permission denied: %v", err)
+	}
+	p := command.GetPrincipal()
+	if replays.Seen(p, now) {
+		return fmt.Errorf("permission denied: request of %q was replayed", p.Name)
+	}
+
+	for _, action := range actions {
+		if err := policy.Allow(p.Name, role, action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Allow fails unless role may run action, name is only reported
+func (policy Policy) Allow(name string, role Role, action string) error {
+	required, ok := policy[action]
+	if !ok {
+		required = ADMIN
+	}
+	if role < required {
+		return fmt.Errorf("permission denied: %s %q may not %s", role, name, action)
+	}
+
+	return nil
+}
+
+// Identify names the holder of cert after its common name and gives it
+// the role in its organizational unit, the CA vouches for both
+func Identify(cert *x509.Certificate) (string, Role, error) {
+	name := cert.Subject.CommonName
+	for _, unit := range cert.Subject.OrganizationalUnit {
+		if role, err := ParseRole(unit); err == nil {
+			return name, role, nil
+		}
+	}
+
+	return name, NONE, fmt.Errorf("certificate of %q carries no role", name)
+}
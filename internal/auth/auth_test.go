@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"dec/messages"
+)
+
+func newAuthority(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return public, private
+}
+
+func newCredentials(t *testing.T, authority ed25519.PrivateKey, name string, role Role) *Credentials {
+	c, err := NewCredentials(authority, name, role, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+// signed returns a pickup signed by c
+func signed(c *Credentials) *messages.PickupRequest {
+	msg := &messages.PickupRequest{Floor: 3, State: 1, Request: 7}
+	c.Sign(msg)
+
+	return msg
+}
+
+func TestVerify(t *testing.T) {
+	public, private := newAuthority(t)
+	alice := newCredentials(t, private, "alice", OPERATOR)
+	now := time.Now()
+
+	role, err := Verify(public, signed(alice), now)
+	if err != nil || role != OPERATOR {
+		t.Error("Expected operator, got ", role, err)
+	}
+
+	// promoting yourself breaks the signature of the authority
+	msg := signed(alice)
+	msg.Principal.Role = "admin"
+	if _, err := Verify(public, msg, now); err == nil {
+		t.Error("Expected an error, got none")
+	}
+
+	// the proof is bound to the request
+	msg = signed(alice)
+	msg.Floor = 4
+	if _, err := Verify(public, msg, now); err == nil {
+		t.Error("Expected a changed request to be refused")
+	}
+
+	// the token is useless without the key of its holder
+	mallory := newCredentials(t, private, "mallory", PASSENGER)
+	stolen := &Credentials{Principal: alice.Principal, Key: mallory.Key}
+	if _, err := Verify(public, signed(stolen), now); err == nil {
+		t.Error("Expected a stolen token to be refused")
+	}
+
+	// tokens expire and requests go stale
+	if _, err := Verify(public, signed(alice), now.Add(2*time.Hour)); err == nil {
+		t.Error("Expected an expired token to be refused")
+	}
+	if _, err := Verify(public, signed(alice), now.Add(2*MAX_SKEW)); err == nil {
+		t.Error("Expected an old request to be refused")
+	}
+
+	other, _ := newAuthority(t)
+	if _, err := Verify(other, signed(alice), now); err == nil {
+		t.Error("Expected an error, got none")
+	}
+	if _, err := Verify(public, &messages.PickupRequest{}, now); err == nil {
+		t.Error("Expected an error, got none")
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	public, private := newAuthority(t)
+	replays := NewReplays()
+	passenger := newCredentials(t, private, "bob", PASSENGER)
+	operator := newCredentials(t, private, "alice", OPERATOR)
+	admin := newCredentials(t, private, "root", ADMIN)
+
+	if err := DefaultPolicy.Authorize(public, replays, signed(passenger), ACTION_PICKUP); err != nil {
+		t.Error("Expected passengers to pick up, got ", err)
+	}
+	if err := DefaultPolicy.Authorize(public, replays, signed(passenger), ACTION_UPDATE); err == nil {
+		t.Error("Expected passengers to be denied car calls")
+	}
+	if err := DefaultPolicy.Authorize(public, replays, signed(operator), ACTION_UPDATE); err != nil {
+		t.Error("Expected operators to place car calls, got ", err)
+	}
+	// unlisted actions are for admins only
+	if err := DefaultPolicy.Authorize(public, replays, signed(operator), "recall"); err == nil {
+		t.Error("Expected operators to be denied recall")
+	}
+	if err := DefaultPolicy.Authorize(public, replays, signed(admin), "recall"); err != nil {
+		t.Error("Expected admins to recall, got ", err)
+	}
+
+	// a request is taken once
+	msg := signed(admin)
+	if err := DefaultPolicy.Authorize(public, replays, msg, ACTION_STEP); err != nil {
+		t.Error("Expected admins to step, got ", err)
+	}
+	if err := DefaultPolicy.Authorize(public, replays, msg, ACTION_STEP); err == nil {
+		t.Error("Expected a replayed request to be refused")
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	private := filepath.Join(dir, "authority.key")
+	public := filepath.Join(dir, "authority.pub")
+	if err := GenerateAuthority(private, public); err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := LoadAuthority(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := LoadAuthorityKey(public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the public key can't issue
+	if _, err := LoadAuthority(public); err == nil {
+		t.Error("Expected an error, got none")
+	}
+
+	path := filepath.Join(dir, "alice.credentials")
+	if err := newCredentials(t, issuer, "alice", OPERATOR).Save(path); err != nil {
+		t.Fatal(err)
+	}
+	alice, err := LoadCredentials(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role, err := Verify(verifier, signed(alice), time.Now()); err != nil || role != OPERATOR {
+		t.Error("Expected operator, got ", role, err)
+	}
+
+	if c, err := LoadCredentials(""); c != nil || err != nil {
+		t.Error("Expected no credentials, got ", c, err)
+	}
+	var none *Credentials
+	if err := none.Sign(&messages.PickupRequest{}); err != nil {
+		t.Error("Expected nil credentials to sign nothing, got ", err)
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "alice", OrganizationalUnit: []string{"ops", "operator"}}}
+	name, role, err := Identify(cert)
+	if err != nil || name != "alice" || role != OPERATOR {
+		t.Error("Expected operator alice, got ", name, role, err)
+	}
+
+	cert.Subject.OrganizationalUnit = []string{"ops"}
+	if _, _, err := Identify(cert); err == nil {
+		t.Error("Expected an error, got none")
+	}
+}
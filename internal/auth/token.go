@@ -0,0 +1,336 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"dec/messages"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+const (
+	// Time tokens are issued for by default
+	TOKEN_TTL = 24 * time.Hour
+	// Drift allowed between the clock of a client and the one of an
+	// elevator, requests older than this are refused as replays
+	MAX_SKEW = 30 * time.Second
+)
+
+const (
+	PEM_PRIVATE_KEY = "PRIVATE KEY"
+	PEM_PUBLIC_KEY  = "PUBLIC KEY"
+	PEM_PRINCIPAL   = "DEC PRINCIPAL"
+)
+
+// Command is a request carrying the principal that sent it
+type Command interface {
+	proto.Message
+	messages.Signed
+}
+
+func writePEM(path string, blocks ...*pem.Block) error {
+	var buf bytes.Buffer
+	for _, block := range blocks {
+		if err := pem.Encode(&buf, block); err != nil {
+			return err
+		}
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// readPEM returns the blocks of the file at path by type
+func readPEM(path string) (map[string][]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string][]byte)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		blocks[block.Type] = block.Bytes
+	}
+
+	return blocks, nil
+}
+
+func parsePrivateKey(der []byte) (ed25519.PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an ed25519 key")
+	}
+
+	return private, nil
+}
+
+func marshalPrivateKey(key ed25519.PrivateKey) (*pem.Block, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pem.Block{Type: PEM_PRIVATE_KEY, Bytes: der}, nil
+}
+
+// GenerateAuthority writes a new key pair for the authority, the private
+// key issues tokens and the public key is handed to the elevators
+func GenerateAuthority(privateFile string, publicFile string) error {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	block, err := marshalPrivateKey(private)
+	if err != nil {
+		return err
+	}
+	if err := writePEM(privateFile, block); err != nil {
+		return err
+	}
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return err
+	}
+
+	return writePEM(publicFile, &pem.Block{Type: PEM_PUBLIC_KEY, Bytes: der})
+}
+
+// LoadAuthority reads the private key of the authority from path
+func LoadAuthority(path string) (ed25519.PrivateKey, error) {
+	blocks, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	der, ok := blocks[PEM_PRIVATE_KEY]
+	if !ok {
+		return nil, fmt.Errorf("%s holds no private key", path)
+	}
+
+	return parsePrivateKey(der)
+}
+
+// LoadAuthorityKey reads the public key of the authority from path
+func LoadAuthorityKey(path string) (ed25519.PublicKey, error) {
+	blocks, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	der, ok := blocks[PEM_PUBLIC_KEY]
+	if !ok {
+		return nil, fmt.Errorf("%s holds no public key", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s holds no ed25519 key", path)
+	}
+
+	return public, nil
+}
+
+// token is what the authority signs, every field is length prefixed so
+// none can bleed into the next
+func token(p *messages.Principal) []byte {
+	var buf bytes.Buffer
+	for _, field := range [][]byte{[]byte(p.Name), []byte(p.Role), p.Key} {
+		binary.Write(&buf, binary.BigEndian, uint32(len(field)))
+		buf.Write(field)
+	}
+	binary.Write(&buf, binary.BigEndian, p.Expires)
+
+	return buf.Bytes()
+}
+
+// Issue returns a token for name acting as role until expires, only the
+// holder of the private key of holder can use it
+func Issue(authority ed25519.PrivateKey, name string, role Role, holder ed25519.PublicKey, expires time.Time) *messages.Principal {
+	p := &messages.Principal{
+		Name:    name,
+		Role:    role.String(),
+		Expires: expires.Unix(),
+		Key:     holder,
+	}
+	p.Signature = ed25519.Sign(authority, token(p))
+
+	return p
+}
+
+// Credentials are a token and the key proving it
+type Credentials struct {
+	Principal *messages.Principal
+	Key       ed25519.PrivateKey
+}
+
+// NewCredentials issues a token for name acting as role for ttl along
+// with a fresh key of the holder
+func NewCredentials(authority ed25519.PrivateKey, name string, role Role, ttl time.Duration) (*Credentials, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{
+		Principal: Issue(authority, name, role, public, time.Now().Add(ttl)),
+		Key:       private,
+	}, nil
+}
+
+// Save writes c to path, readable by its owner only
+func (c *Credentials) Save(path string) error {
+	principal, err := proto.Marshal(c.Principal)
+	if err != nil {
+		return err
+	}
+	key, err := marshalPrivateKey(c.Key)
+	if err != nil {
+		return err
+	}
+
+	return writePEM(path, &pem.Block{Type: PEM_PRINCIPAL, Bytes: principal}, key)
+}
+
+// LoadCredentials reads the credentials saved at path, there are none
+// when path is empty
+func LoadCredentials(path string) (*Credentials, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	blocks, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if blocks[PEM_PRINCIPAL] == nil || blocks[PEM_PRIVATE_KEY] == nil {
+		return nil, fmt.Errorf("%s holds no credentials", path)
+	}
+
+	c := &Credentials{Principal: &messages.Principal{}}
+	if err := proto.Unmarshal(blocks[PEM_PRINCIPAL], c.Principal); err != nil {
+		return nil, err
+	}
+	if c.Key, err = parsePrivateKey(blocks[PEM_PRIVATE_KEY]); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(c.Key.Public().(ed25519.PublicKey), c.Principal.Key) {
+		return nil, fmt.Errorf("%s holds a key the token was not issued for", path)
+	}
+
+	return c, nil
+}
+
+// request is the command as its holder signed it, without the proof.
+// Broadcasts reach several cars at once, so command is left alone.
+func request(command Command) ([]byte, error) {
+	clone := proto.Clone(command).(Command)
+	clone.GetPrincipal().Proof = nil
+
+	return proto.Marshal(clone)
+}
+
+// Sign gives command the token, stamped with the time it is sent at,
+// and proves it for command. It is called once command is complete, nil
+// credentials sign nothing.
+func (c *Credentials) Sign(command Command) error {
+	if c == nil {
+		return nil
+	}
+
+	p := *c.Principal
+	p.Time = time.Now().UnixNano()
+	p.Proof = nil
+	command.SetPrincipal(&p)
+	data, err := proto.Marshal(command)
+	if err != nil {
+		return err
+	}
+	p.Proof = ed25519.Sign(c.Key, data)
+
+	return nil
+}
+
+// Verify checks that the principal of command was issued by authority,
+// is not expired at now and was proven by its holder for command. It
+// returns the role of the principal.
+func Verify(authority ed25519.PublicKey, command Command, now time.Time) (Role, error) {
+	p := command.GetPrincipal()
+	if p == nil {
+		return NONE, errors.New("missing principal")
+	}
+	if !ed25519.Verify(authority, token(p), p.Signature) {
+		return NONE, fmt.Errorf("invalid signature for %q", p.Name)
+	}
+	if now.Unix() > p.Expires {
+		return NONE, fmt.Errorf("token of %q expired", p.Name)
+	}
+	sent := time.Unix(0, p.Time)
+	if sent.Before(now.Add(-MAX_SKEW)) || sent.After(now.Add(MAX_SKEW)) {
+		return NONE, fmt.Errorf("request of %q is not recent", p.Name)
+	}
+
+	data, err := request(command)
+	if err != nil {
+		return NONE, err
+	}
+	if len(p.Key) != ed25519.PublicKeySize || !ed25519.Verify(ed25519.PublicKey(p.Key), data, p.Proof) {
+		return NONE, fmt.Errorf("invalid proof for %q", p.Name)
+	}
+
+	return ParseRole(p.Role)
+}
+
+// Replays remembers the requests taken within MAX_SKEW, older ones are
+// refused by Verify already
+type Replays struct {
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	pruned time.Time
+}
+
+func NewReplays() *Replays {
+	return &Replays{seen: make(map[string]time.Time)}
+}
+
+// Seen reports whether the request p was sent with was taken before and
+// remembers it otherwise
+func (r *Replays) Seen(p *messages.Principal, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.pruned) > MAX_SKEW {
+		for proof, sent := range r.seen {
+			if now.Sub(sent) > MAX_SKEW {
+				delete(r.seen, proof)
+			}
+		}
+		r.pruned = now
+	}
+
+	if _, ok := r.seen[string(p.Proof)]; ok {
+		return true
+	}
+	r.seen[string(p.Proof)] = time.Unix(0, p.Time)
+
+	return false
+}
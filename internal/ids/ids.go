@@ -0,0 +1,49 @@
+// Package ids parses the elevator ids given on the command line, either
+// as a list, a range or a mix of both such as 0-3,8,12-15.
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Most ids a single spec may name, every one of them is an actor
+const MAX_IDS = 1024
+
+// Parse returns the ids of spec in the order given, duplicates and specs
+// naming more than MAX_IDS ids are refused
+func Parse(spec string) ([]uint, error) {
+	ids := []uint{}
+	seen := map[uint]bool{}
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		bounds := strings.SplitN(part, "-", 2)
+
+		first, err := strconv.ParseUint(bounds[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q", part)
+		}
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.ParseUint(bounds[1], 10, 32)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+		}
+		if last-first+1 > uint64(MAX_IDS-len(ids)) {
+			return nil, fmt.Errorf("%q names more than %d ids", spec, MAX_IDS)
+		}
+
+		for id := first; id <= last; id++ {
+			if seen[uint(id)] {
+				return nil, fmt.Errorf("id %d given twice", id)
+			}
+			seen[uint(id)] = true
+			ids = append(ids, uint(id))
+		}
+	}
+
+	return ids, nil
+}
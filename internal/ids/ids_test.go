@@ -0,0 +1,22 @@
+package ids
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	ids, err := Parse("0-3, 8,12-13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []uint{0, 1, 2, 3, 8, 12, 13}; !reflect.DeepEqual(ids, expected) {
+		t.Error("Expected ", expected, ", got ", ids)
+	}
+
+	for _, spec := range []string{"", "a", "3-1", "1-", "0-2,2", "0-4294967295", "0-1023,1024"} {
+		if _, err := Parse(spec); err == nil {
+			t.Error("Expected an error for ", spec)
+		}
+	}
+}
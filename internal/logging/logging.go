@@ -0,0 +1,67 @@
+// Package logging sets up the leveled logs of every process. Entries carry
+// the elevator, request, floor and direction they are about as fields so
+// the logs of a building can be aggregated and queried, the request being
+// the trace id the call travels under.
+package logging
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Fields shared by the entries of every process
+const (
+	FIELD_ELEVATOR  = "elevator"
+	FIELD_REQUEST   = "request"
+	FIELD_FLOOR     = "floor"
+	FIELD_DIRECTION = "direction"
+)
+
+// Setup logs entries from level up, as JSON when format is json and as
+// text otherwise
+func Setup(level string, format string) error {
+	l, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	log.SetLevel(l)
+
+	switch format {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	case "text":
+		log.SetFormatter(&log.TextFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %q, expected text or json", format)
+	}
+
+	return nil
+}
+
+// Elevator returns an entry about elevator id
+func Elevator(id interface{}) *log.Entry {
+	return log.WithField(FIELD_ELEVATOR, id)
+}
+
+// Request returns an entry about the request the span in ctx belongs to
+func Request(ctx context.Context) *log.Entry {
+	return Span(trace.SpanFromContext(ctx), log.NewEntry(log.StandardLogger()))
+}
+
+// Span returns entry along with the request span belongs to
+func Span(span trace.Span, entry *log.Entry) *log.Entry {
+	sc := span.SpanContext()
+	if !sc.HasTraceID() {
+		return entry
+	}
+
+	return entry.WithField(FIELD_REQUEST, sc.TraceID().String())
+}
+
+// Call returns entry along with the floor and direction of a hall call
+func Call(entry *log.Entry, floor uint32, direction int32) *log.Entry {
+	return entry.WithFields(log.Fields{FIELD_FLOOR: floor, FIELD_DIRECTION: direction})
+}
@@ -0,0 +1,34 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSetup(t *testing.T) {
+	if err := Setup("debug", "json"); err != nil {
+		t.Error("Expected no error, got ", err)
+	}
+	if err := Setup("loud", "text"); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+	if err := Setup("info", "xml"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestRequest(t *testing.T) {
+	if _, ok := Request(context.Background()).Data[FIELD_REQUEST]; ok {
+		t.Error("Expected no request outside a span")
+	}
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "pickup")
+	defer span.End()
+
+	id := span.SpanContext().TraceID().String()
+	if v := Request(ctx).Data[FIELD_REQUEST]; v != id {
+		t.Error("Expected ", id, ", got ", v)
+	}
+}
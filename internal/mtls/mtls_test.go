@@ -0,0 +1,175 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func writePEM(t *testing.T, path string, typ string, der []byte) {
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue writes a key pair for 127.0.0.1 signed by ca along with the ca
+// itself into dir and loads them
+func (ca *testCA) issue(t *testing.T, dir string, name string) *tls.Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	caFile := filepath.Join(dir, name+".ca")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDer)
+	if err := ioutil.WriteFile(caFile, ca.pem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := Load(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return config
+}
+
+// handshake dials a listener serving server with client and returns
+// the verdict of the server
+func handshake(t *testing.T, server *tls.Config, client *tls.Config) error {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	go func() {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+		if err == nil {
+			conn.Close()
+		}
+	}()
+
+	conn, err := lis.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	return conn.(*tls.Conn).Handshake()
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mtls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t, "dec")
+	elevator := ca.issue(t, dir, "elevator")
+	client := ca.issue(t, dir, "client")
+
+	if err := handshake(t, elevator, client); err != nil {
+		t.Error("Expected trusted peers to connect, got ", err)
+	}
+
+	// a peer from another CA is refused by the server. The rogue trusts
+	// the server and presents its certificate even though the server asks
+	// for another CA, so only its own certificate is foreign.
+	rogue := newTestCA(t, "rogue").issue(t, dir, "rogue")
+	rogue.RootCAs = client.RootCAs
+	foreign := rogue.Certificates[0]
+	rogue.Certificates = nil
+	rogue.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return &foreign, nil
+	}
+	if err := handshake(t, elevator, rogue); err == nil || !strings.Contains(err.Error(), "unknown authority") {
+		t.Error("Expected the server to refuse the untrusted client, got ", err)
+	}
+
+	// a peer without a certificate is refused too
+	anonymous := &tls.Config{RootCAs: client.RootCAs}
+	if err := handshake(t, elevator, anonymous); err == nil || !strings.Contains(err.Error(), "didn't provide a certificate") {
+		t.Error("Expected the server to refuse the anonymous client, got ", err)
+	}
+}
+
+func TestRemotingOptions(t *testing.T) {
+	options, err := RemotingOptions("", "", "")
+	if err != nil || options != nil {
+		t.Error("Expected no options without files, got ", options, err)
+	}
+
+	if _, err := RemotingOptions("elevator.crt", "", ""); err == nil {
+		t.Error("Expected an error for a partial configuration")
+	}
+}
@@ -0,0 +1,114 @@
+// Package tracing exports the spans of clients and elevators through
+// OpenTelemetry. Trace context travels between actors as a W3C
+// traceparent in the requests, so a pickup can be followed from the
+// dispatch decision into the elevator handling it.
+package tracing
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Name of the tracer every span of the module is started with
+const TRACER = "dec"
+
+// Time Shutdown gives the exporter to flush the spans still buffered
+const FLUSH_TIMEOUT = 5 * time.Second
+
+var propagator = propagation.TraceContext{}
+
+// Tracer starts spans on the provider installed by Start, spans are
+// dropped until one is
+func Tracer() trace.Tracer {
+	return otel.Tracer(TRACER)
+}
+
+// Start installs a provider exporting the spans of service to exporter
+// and returns the func flushing and stopping it
+func Start(service string, exporter sdktrace.SpanExporter) func() {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(sdkresource.NewSchemaless(
+			attribute.String("service.name", service),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), FLUSH_TIMEOUT)
+		defer cancel()
+		provider.Shutdown(ctx)
+	}
+}
+
+// Setup exports the spans of service to stdout, or to the file at path
+// with one JSON span per line. Nothing is exported when path is empty,
+// requests still get a trace id for the logs to name them by.
+func Setup(service string, path string) (func(), error) {
+	if path == "" {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		return func() {}, nil
+	}
+
+	var w io.Writer = os.Stdout
+	var f *os.File
+	if path != "stdout" {
+		var err error
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		w = f
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return nil, err
+	}
+	stop := Start(service, exporter)
+
+	return func() {
+		stop()
+		if f != nil {
+			f.Close()
+		}
+	}, nil
+}
+
+// End records err on span, if any, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject returns the traceparent of the span in ctx, empty without one
+func Inject(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	return carrier.Get("traceparent")
+}
+
+// Extract returns ctx with the remote span described by traceparent as
+// its parent
+func Extract(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recorder keeps the exported spans past the shutdown
+type recorder struct {
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *recorder) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *recorder) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func TestPropagation(t *testing.T) {
+	exporter := &recorder{}
+	stop := Start("test", exporter)
+
+	ctx, span := Tracer().Start(context.Background(), "client")
+	traceparent := Inject(ctx)
+	if traceparent == "" {
+		t.Fatal("Expected a traceparent, got none")
+	}
+
+	_, child := Tracer().Start(Extract(context.Background(), traceparent), "elevator")
+	child.End()
+	span.End()
+	stop()
+
+	spans := exporter.spans
+	if len(spans) != 2 {
+		t.Fatal("Expected 2 spans, got ", len(spans))
+	}
+	if spans[0].Parent().SpanID() != span.SpanContext().SpanID() {
+		t.Error("Expected the elevator span under the client span, got ", spans[0].Parent())
+	}
+
+	if Inject(context.Background()) != "" {
+		t.Error("Expected no traceparent without a span")
+	}
+	if trace.SpanContextFromContext(Extract(context.Background(), "")).IsValid() {
+		t.Error("Expected no span from an empty traceparent")
+	}
+}
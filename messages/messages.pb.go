@@ -8,6 +8,8 @@ import fmt "fmt"
 import math "math"
 import actor "github.com/AsynkronIT/protoactor-go/actor"
 
+import bytes "bytes"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -24,14 +26,284 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// Token issued by the authority, proven by its holder on every request
+type Principal struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+	// Signature of the authority over the name, role, expiry and key
+	Signature []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	// Unix time the token is honoured until
+	Expires int64 `protobuf:"varint,4,opt,name=Expires,proto3" json:"Expires,omitempty"`
+	// Public key of the holder
+	Key []byte `protobuf:"bytes,5,opt,name=Key,proto3" json:"Key,omitempty"`
+	// Unix nanoseconds the request was sent at
+	Time int64 `protobuf:"varint,6,opt,name=Time,proto3" json:"Time,omitempty"`
+	// Signature of the holder over the request it is sent with
+	Proof []byte `protobuf:"bytes,7,opt,name=Proof,proto3" json:"Proof,omitempty"`
+}
+
+func (m *Principal) Reset()      { *m = Principal{} }
+func (*Principal) ProtoMessage() {}
+func (*Principal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{0}
+}
+func (m *Principal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Principal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Principal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Principal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Principal.Merge(dst, src)
+}
+func (m *Principal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Principal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Principal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Principal proto.InternalMessageInfo
+
+func (m *Principal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Principal) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Principal) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Principal) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *Principal) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Principal) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Principal) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type HelloRequest struct {
+	Sender   *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Version  uint32     `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Features []string   `protobuf:"bytes,3,rep,name=Features,proto3" json:"Features,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,4,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,5,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *HelloRequest) Reset()      { *m = HelloRequest{} }
+func (*HelloRequest) ProtoMessage() {}
+func (*HelloRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{1}
+}
+func (m *HelloRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelloRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *HelloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloRequest.Merge(dst, src)
+}
+func (m *HelloRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelloRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelloRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelloRequest proto.InternalMessageInfo
+
+func (m *HelloRequest) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *HelloRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HelloRequest) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *HelloRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
+func (m *HelloRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
+type HelloResponse struct {
+	Id           uint32   `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Version      uint32   `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Floors       uint32   `protobuf:"varint,3,opt,name=Floors,proto3" json:"Floors,omitempty"`
+	ServedFloors []uint32 `protobuf:"varint,4,rep,packed,name=ServedFloors,proto3" json:"ServedFloors,omitempty"`
+	Features     []string `protobuf:"bytes,5,rep,name=Features,proto3" json:"Features,omitempty"`
+	Error        string   `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Request this answers, see the requests
+	Request uint64 `protobuf:"varint,7,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *HelloResponse) Reset()      { *m = HelloResponse{} }
+func (*HelloResponse) ProtoMessage() {}
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{2}
+}
+func (m *HelloResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelloResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *HelloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloResponse.Merge(dst, src)
+}
+func (m *HelloResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelloResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelloResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelloResponse proto.InternalMessageInfo
+
+func (m *HelloResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HelloResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HelloResponse) GetFloors() uint32 {
+	if m != nil {
+		return m.Floors
+	}
+	return 0
+}
+
+func (m *HelloResponse) GetServedFloors() []uint32 {
+	if m != nil {
+		return m.ServedFloors
+	}
+	return nil
+}
+
+func (m *HelloResponse) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *HelloResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *HelloResponse) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
 type StatusRequest struct {
 	Sender *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,2,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,3,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
 func (m *StatusRequest) Reset()      { *m = StatusRequest{} }
 func (*StatusRequest) ProtoMessage() {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_4c66d86304e563a1, []int{0}
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{3}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -67,17 +339,36 @@ func (m *StatusRequest) GetSender() *actor.PID {
 	return nil
 }
 
+func (m *StatusRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
+func (m *StatusRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
 type StatusResponse struct {
-	Id    uint32 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Floor uint32 `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
-	Goal  int32  `protobuf:"varint,3,opt,name=Goal,proto3" json:"Goal,omitempty"`
-	State int32  `protobuf:"varint,4,opt,name=State,proto3" json:"State,omitempty"`
+	Id       uint32 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Floor    uint32 `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	Goal     int32  `protobuf:"varint,3,opt,name=Goal,proto3" json:"Goal,omitempty"`
+	State    int32  `protobuf:"varint,4,opt,name=State,proto3" json:"State,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	Draining bool   `protobuf:"varint,6,opt,name=Draining,proto3" json:"Draining,omitempty"`
+	Faulted  bool   `protobuf:"varint,7,opt,name=Faulted,proto3" json:"Faulted,omitempty"`
+	// Request this answers, see the requests
+	Request uint64 `protobuf:"varint,8,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
 func (m *StatusResponse) Reset()      { *m = StatusResponse{} }
 func (*StatusResponse) ProtoMessage() {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_4c66d86304e563a1, []int{1}
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{4}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,16 +425,49 @@ func (m *StatusResponse) GetState() int32 {
 	return 0
 }
 
+func (m *StatusResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *StatusResponse) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *StatusResponse) GetFaulted() bool {
+	if m != nil {
+		return m.Faulted
+	}
+	return false
+}
+
+func (m *StatusResponse) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
 type UpdateRequest struct {
-	Sender *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
-	Goal   uint32     `protobuf:"varint,2,opt,name=Goal,proto3" json:"Goal,omitempty"`
-	State  int32      `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Goal      uint32     `protobuf:"varint,2,opt,name=Goal,proto3" json:"Goal,omitempty"`
+	State     int32      `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	Principal *Principal `protobuf:"bytes,4,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,5,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,6,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()      { *m = UpdateRequest{} }
 func (*UpdateRequest) ProtoMessage() {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_4c66d86304e563a1, []int{2}
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{5}
 }
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,16 +517,42 @@ func (m *UpdateRequest) GetState() int32 {
 	return 0
 }
 
+func (m *UpdateRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
 type PickupRequest struct {
-	Sender *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
-	Floor  uint32     `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
-	State  int32      `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Floor     uint32     `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	State     int32      `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	Principal *Principal `protobuf:"bytes,4,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,5,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,6,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
 func (m *PickupRequest) Reset()      { *m = PickupRequest{} }
 func (*PickupRequest) ProtoMessage() {}
 func (*PickupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_4c66d86304e563a1, []int{3}
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{6}
 }
 func (m *PickupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,21 +602,49 @@ func (m *PickupRequest) GetState() int32 {
 	return 0
 }
 
-type StepRequest struct {
-	Sender *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+func (m *PickupRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
+	}
+	return nil
 }
 
-func (m *StepRequest) Reset()      { *m = StepRequest{} }
-func (*StepRequest) ProtoMessage() {}
-func (*StepRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_4c66d86304e563a1, []int{4}
+func (m *PickupRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
 }
-func (m *StepRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PickupRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
+	}
+	return 0
+}
+
+type DestinationPickupRequest struct {
+	Sender      *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Floor       uint32     `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	Destination uint32     `protobuf:"varint,3,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	Principal   *Principal `protobuf:"bytes,4,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,5,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,6,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *DestinationPickupRequest) Reset()      { *m = DestinationPickupRequest{} }
+func (*DestinationPickupRequest) ProtoMessage() {}
+func (*DestinationPickupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{7}
+}
+func (m *DestinationPickupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DestinationPickupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StepRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DestinationPickupRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -276,589 +654,9095 @@ func (m *StepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *StepRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StepRequest.Merge(dst, src)
+func (dst *DestinationPickupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationPickupRequest.Merge(dst, src)
 }
-func (m *StepRequest) XXX_Size() int {
+func (m *DestinationPickupRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StepRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StepRequest.DiscardUnknown(m)
+func (m *DestinationPickupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationPickupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StepRequest proto.InternalMessageInfo
+var xxx_messageInfo_DestinationPickupRequest proto.InternalMessageInfo
 
-func (m *StepRequest) GetSender() *actor.PID {
+func (m *DestinationPickupRequest) GetSender() *actor.PID {
 	if m != nil {
 		return m.Sender
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StatusRequest)(nil), "messages.StatusRequest")
-	proto.RegisterType((*StatusResponse)(nil), "messages.StatusResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "messages.UpdateRequest")
-	proto.RegisterType((*PickupRequest)(nil), "messages.PickupRequest")
-	proto.RegisterType((*StepRequest)(nil), "messages.StepRequest")
-}
-func (this *StatusRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DestinationPickupRequest) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
 	}
+	return 0
+}
 
-	that1, ok := that.(*StatusRequest)
-	if !ok {
-		that2, ok := that.(StatusRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DestinationPickupRequest) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *DestinationPickupRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
 	}
-	if !this.Sender.Equal(that1.Sender) {
-		return false
+	return nil
+}
+
+func (m *DestinationPickupRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
 	}
-	return true
+	return ""
 }
-func (this *StatusResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DestinationPickupRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
 	}
+	return 0
+}
 
-	that1, ok := that.(*StatusResponse)
-	if !ok {
-		that2, ok := that.(StatusResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CancelRequest struct {
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Floor     uint32     `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	Principal *Principal `protobuf:"bytes,3,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,4,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,5,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *CancelRequest) Reset()      { *m = CancelRequest{} }
+func (*CancelRequest) ProtoMessage() {}
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{8}
+}
+func (m *CancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
+}
+func (dst *CancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRequest.Merge(dst, src)
+}
+func (m *CancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRequest proto.InternalMessageInfo
+
+func (m *CancelRequest) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
 	}
-	if this.Floor != that1.Floor {
-		return false
+	return nil
+}
+
+func (m *CancelRequest) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
 	}
-	if this.Goal != that1.Goal {
-		return false
+	return 0
+}
+
+func (m *CancelRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
 	}
-	if this.State != that1.State {
-		return false
+	return nil
+}
+
+func (m *CancelRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
 	}
-	return true
+	return ""
 }
-func (this *UpdateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CancelRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
 	}
+	return 0
+}
 
-	that1, ok := that.(*UpdateRequest)
-	if !ok {
-		that2, ok := that.(UpdateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Command struct {
+	// Types that are valid to be assigned to Command:
+	//	*Command_Update
+	//	*Command_Pickup
+	Command isCommand_Command `protobuf_oneof:"Command"`
+}
+
+func (m *Command) Reset()      { *m = Command{} }
+func (*Command) ProtoMessage() {}
+func (*Command) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{9}
+}
+func (m *Command) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Command) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Command.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Sender.Equal(that1.Sender) {
-		return false
+}
+func (dst *Command) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Command.Merge(dst, src)
+}
+func (m *Command) XXX_Size() int {
+	return m.Size()
+}
+func (m *Command) XXX_DiscardUnknown() {
+	xxx_messageInfo_Command.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Command proto.InternalMessageInfo
+
+type isCommand_Command interface {
+	isCommand_Command()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Command_Update struct {
+	Update *UpdateRequest `protobuf:"bytes,1,opt,name=Update,oneof"`
+}
+type Command_Pickup struct {
+	Pickup *PickupRequest `protobuf:"bytes,2,opt,name=Pickup,oneof"`
+}
+
+func (*Command_Update) isCommand_Command() {}
+func (*Command_Pickup) isCommand_Command() {}
+
+func (m *Command) GetCommand() isCommand_Command {
+	if m != nil {
+		return m.Command
 	}
-	if this.Goal != that1.Goal {
-		return false
+	return nil
+}
+
+func (m *Command) GetUpdate() *UpdateRequest {
+	if x, ok := m.GetCommand().(*Command_Update); ok {
+		return x.Update
 	}
-	if this.State != that1.State {
-		return false
+	return nil
+}
+
+func (m *Command) GetPickup() *PickupRequest {
+	if x, ok := m.GetCommand().(*Command_Pickup); ok {
+		return x.Pickup
 	}
-	return true
+	return nil
 }
-func (this *PickupRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Command) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Command_OneofMarshaler, _Command_OneofUnmarshaler, _Command_OneofSizer, []interface{}{
+		(*Command_Update)(nil),
+		(*Command_Pickup)(nil),
 	}
+}
 
-	that1, ok := that.(*PickupRequest)
-	if !ok {
-		that2, ok := that.(PickupRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func _Command_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Command)
+	// Command
+	switch x := m.Command.(type) {
+	case *Command_Update:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Update); err != nil {
+			return err
 		}
+	case *Command_Pickup:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Pickup); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Command.Command has unexpected type %T", x)
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Sender.Equal(that1.Sender) {
-		return false
-	}
-	if this.Floor != that1.Floor {
-		return false
-	}
-	if this.State != that1.State {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *StepRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*StepRequest)
-	if !ok {
-		that2, ok := that.(StepRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func _Command_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Command)
+	switch tag {
+	case 1: // Command.Update
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
+		msg := new(UpdateRequest)
+		err := b.DecodeMessage(msg)
+		m.Command = &Command_Update{msg}
+		return true, err
+	case 2: // Command.Pickup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PickupRequest)
+		err := b.DecodeMessage(msg)
+		m.Command = &Command_Pickup{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Sender.Equal(that1.Sender) {
-		return false
+}
+
+func _Command_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Command)
+	// Command
+	switch x := m.Command.(type) {
+	case *Command_Update:
+		s := proto.Size(x.Update)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Command_Pickup:
+		s := proto.Size(x.Pickup)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return true
+	return n
 }
-func (this *StatusRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+type BatchRequest struct {
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Commands  []*Command `protobuf:"bytes,2,rep,name=Commands" json:"Commands,omitempty"`
+	Principal *Principal `protobuf:"bytes,3,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,4,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,5,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *BatchRequest) Reset()      { *m = BatchRequest{} }
+func (*BatchRequest) ProtoMessage() {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{10}
+}
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.StatusRequest{")
-	if this.Sender != nil {
-		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+}
+func (dst *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(dst, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *StatusResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *BatchRequest) GetCommands() []*Command {
+	if m != nil {
+		return m.Commands
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&messages.StatusResponse{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
-	s = append(s, "Goal: "+fmt.Sprintf("%#v", this.Goal)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *UpdateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *BatchRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.UpdateRequest{")
-	if this.Sender != nil {
-		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	return nil
+}
+
+func (m *BatchRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
 	}
-	s = append(s, "Goal: "+fmt.Sprintf("%#v", this.Goal)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *PickupRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *BatchRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.PickupRequest{")
-	if this.Sender != nil {
-		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	return 0
+}
+
+type StepRequest struct {
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Principal *Principal `protobuf:"bytes,2,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,3,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,4,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *StepRequest) Reset()      { *m = StepRequest{} }
+func (*StepRequest) ProtoMessage() {}
+func (*StepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{11}
+}
+func (m *StepRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StepRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *StepRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (dst *StepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepRequest.Merge(dst, src)
+}
+func (m *StepRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepRequest proto.InternalMessageInfo
+
+func (m *StepRequest) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.StepRequest{")
-	if this.Sender != nil {
-		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	return nil
+}
+
+func (m *StepRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func valueToGoStringMessages(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *StepRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return ""
 }
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *StepRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sender != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
-		n1, err := m.Sender.MarshalTo(dAtA[i:])
+type ArrivalEvent struct {
+	Id    uint32 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Floor uint32 `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	State int32  `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	// Numbers the stops of the car, every event about the same stop
+	// carries the same number
+	Stop uint64 `protobuf:"varint,4,opt,name=Stop,proto3" json:"Stop,omitempty"`
+}
+
+func (m *ArrivalEvent) Reset()      { *m = ArrivalEvent{} }
+func (*ArrivalEvent) ProtoMessage() {}
+func (*ArrivalEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{12}
+}
+func (m *ArrivalEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArrivalEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArrivalEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ArrivalEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrivalEvent.Merge(dst, src)
+}
+func (m *ArrivalEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArrivalEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrivalEvent.DiscardUnknown(m)
 }
 
-func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArrivalEvent proto.InternalMessageInfo
+
+func (m *ArrivalEvent) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
-	}
-	if m.Floor != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
-	}
-	if m.Goal != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Goal))
+func (m *ArrivalEvent) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	return 0
+}
+
+func (m *ArrivalEvent) GetState() int32 {
+	if m != nil {
+		return m.State
 	}
-	return i, nil
+	return 0
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ArrivalEvent) GetStop() uint64 {
+	if m != nil {
+		return m.Stop
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sender != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
-		n2, err := m.Sender.MarshalTo(dAtA[i:])
+type MultiStepRequest struct {
+	Sender    *actor.PID `protobuf:"bytes,1,opt,name=Sender" json:"Sender,omitempty"`
+	Count     uint32     `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	UntilIdle bool       `protobuf:"varint,3,opt,name=UntilIdle,proto3" json:"UntilIdle,omitempty"`
+	Principal *Principal `protobuf:"bytes,4,opt,name=Principal" json:"Principal,omitempty"`
+	// W3C traceparent of the span that sent the request
+	TraceParent string `protobuf:"bytes,5,opt,name=TraceParent,proto3" json:"TraceParent,omitempty"`
+	// Echoed in the answer so the client matches it to this request
+	Request uint64 `protobuf:"varint,6,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (m *MultiStepRequest) Reset()      { *m = MultiStepRequest{} }
+func (*MultiStepRequest) ProtoMessage() {}
+func (*MultiStepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{13}
+}
+func (m *MultiStepRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStepRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
-	}
-	if m.Goal != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Goal))
-	}
-	if m.State != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *MultiStepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStepRequest.Merge(dst, src)
+}
+func (m *MultiStepRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStepRequest.DiscardUnknown(m)
 }
 
-func (m *PickupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiStepRequest proto.InternalMessageInfo
+
+func (m *MultiStepRequest) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PickupRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sender != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
-		n3, err := m.Sender.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.Floor != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+func (m *MultiStepRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	return 0
+}
+
+func (m *MultiStepRequest) GetUntilIdle() bool {
+	if m != nil {
+		return m.UntilIdle
 	}
-	return i, nil
+	return false
 }
 
-func (m *StepRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MultiStepRequest) GetPrincipal() *Principal {
+	if m != nil {
+		return m.Principal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StepRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sender != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
-		n4, err := m.Sender.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+func (m *MultiStepRequest) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
 	}
-	return i, nil
+	return ""
 }
 
-func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MultiStepRequest) GetRequest() uint64 {
+	if m != nil {
+		return m.Request
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return 0
 }
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+type MultiStepResponse struct {
+	Status *StatusResponse `protobuf:"bytes,1,opt,name=Status" json:"Status,omitempty"`
+	Steps  uint32          `protobuf:"varint,2,opt,name=Steps,proto3" json:"Steps,omitempty"`
+	Events []*ArrivalEvent `protobuf:"bytes,3,rep,name=Events" json:"Events,omitempty"`
+}
+
+func (m *MultiStepResponse) Reset()      { *m = MultiStepResponse{} }
+func (*MultiStepResponse) ProtoMessage() {}
+func (*MultiStepResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{14}
+}
+func (m *MultiStepResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStepResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStepResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Sender != nil {
-		l = m.Sender.Size()
-		n += 1 + l + sovMessages(uint64(l))
+}
+func (dst *MultiStepResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStepResponse.Merge(dst, src)
+}
+func (m *MultiStepResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStepResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStepResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStepResponse proto.InternalMessageInfo
+
+func (m *MultiStepResponse) GetStatus() *StatusResponse {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return nil
 }
 
-func (m *StatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStepResponse) GetSteps() uint32 {
+	if m != nil {
+		return m.Steps
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMessages(uint64(m.Id))
+	return 0
+}
+
+func (m *MultiStepResponse) GetEvents() []*ArrivalEvent {
+	if m != nil {
+		return m.Events
 	}
-	if m.Floor != 0 {
-		n += 1 + sovMessages(uint64(m.Floor))
+	return nil
+}
+
+// Persisted state of an elevator, see service/elevator/store.go
+type Snapshot struct {
+	BitVector         uint32         `protobuf:"varint,1,opt,name=BitVector,proto3" json:"BitVector,omitempty"`
+	Floor             uint32         `protobuf:"varint,2,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	State             int32          `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"`
+	LockedPickupFloor uint32         `protobuf:"varint,4,opt,name=LockedPickupFloor,proto3" json:"LockedPickupFloor,omitempty"`
+	LockedDirection   int32          `protobuf:"varint,5,opt,name=LockedDirection,proto3" json:"LockedDirection,omitempty"`
+	Listeners         []*Listener    `protobuf:"bytes,6,rep,name=Listeners" json:"Listeners,omitempty"`
+	PendingCalls      []*PendingCall `protobuf:"bytes,7,rep,name=PendingCalls" json:"PendingCalls,omitempty"`
+	// Index of the last log entry folded into the snapshot
+	Index     uint64      `protobuf:"varint,8,opt,name=Index,proto3" json:"Index,omitempty"`
+	HallCalls []*HallCall `protobuf:"bytes,9,rep,name=HallCalls" json:"HallCalls,omitempty"`
+	// Floors passengers on board asked for, part of BitVector
+	CarCalls uint32 `protobuf:"varint,10,opt,name=CarCalls,proto3" json:"CarCalls,omitempty"`
+	// Clients told when the car drains or faults
+	Clients []*actor.PID `protobuf:"bytes,11,rep,name=Clients" json:"Clients,omitempty"`
+	// The car takes no calls, see DrainEvent and FaultEvent
+	Draining bool `protobuf:"varint,12,opt,name=Draining,proto3" json:"Draining,omitempty"`
+	Faulted  bool `protobuf:"varint,13,opt,name=Faulted,proto3" json:"Faulted,omitempty"`
+}
+
+func (m *Snapshot) Reset()      { *m = Snapshot{} }
+func (*Snapshot) ProtoMessage() {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{15}
+}
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Goal != 0 {
-		n += 1 + sovMessages(uint64(m.Goal))
+}
+func (dst *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(dst, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetBitVector() uint32 {
+	if m != nil {
+		return m.BitVector
 	}
-	if m.State != 0 {
-		n += 1 + sovMessages(uint64(m.State))
+	return 0
+}
+
+func (m *Snapshot) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
 	}
-	return n
+	return 0
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Snapshot) GetState() int32 {
+	if m != nil {
+		return m.State
 	}
-	var l int
-	_ = l
-	if m.Sender != nil {
-		l = m.Sender.Size()
-		n += 1 + l + sovMessages(uint64(l))
+	return 0
+}
+
+func (m *Snapshot) GetLockedPickupFloor() uint32 {
+	if m != nil {
+		return m.LockedPickupFloor
 	}
-	if m.Goal != 0 {
-		n += 1 + sovMessages(uint64(m.Goal))
+	return 0
+}
+
+func (m *Snapshot) GetLockedDirection() int32 {
+	if m != nil {
+		return m.LockedDirection
 	}
-	if m.State != 0 {
-		n += 1 + sovMessages(uint64(m.State))
+	return 0
+}
+
+func (m *Snapshot) GetListeners() []*Listener {
+	if m != nil {
+		return m.Listeners
 	}
-	return n
+	return nil
 }
 
-func (m *PickupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Snapshot) GetPendingCalls() []*PendingCall {
+	if m != nil {
+		return m.PendingCalls
 	}
-	var l int
-	_ = l
-	if m.Sender != nil {
-		l = m.Sender.Size()
-		n += 1 + l + sovMessages(uint64(l))
+	return nil
+}
+
+func (m *Snapshot) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.Floor != 0 {
-		n += 1 + sovMessages(uint64(m.Floor))
+	return 0
+}
+
+func (m *Snapshot) GetHallCalls() []*HallCall {
+	if m != nil {
+		return m.HallCalls
 	}
-	if m.State != 0 {
-		n += 1 + sovMessages(uint64(m.State))
+	return nil
+}
+
+func (m *Snapshot) GetCarCalls() uint32 {
+	if m != nil {
+		return m.CarCalls
 	}
-	return n
+	return 0
 }
 
-func (m *StepRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Snapshot) GetClients() []*actor.PID {
+	if m != nil {
+		return m.Clients
 	}
-	var l int
-	_ = l
-	if m.Sender != nil {
-		l = m.Sender.Size()
-		n += 1 + l + sovMessages(uint64(l))
+	return nil
+}
+
+func (m *Snapshot) GetDraining() bool {
+	if m != nil {
+		return m.Draining
 	}
-	return n
+	return false
 }
 
-func sovMessages(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Snapshot) GetFaulted() bool {
+	if m != nil {
+		return m.Faulted
 	}
-	return n
+	return false
 }
-func sozMessages(x uint64) (n int) {
-	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type Listener struct {
+	Floor uint32     `protobuf:"varint,1,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	Pid   *actor.PID `protobuf:"bytes,2,opt,name=Pid" json:"Pid,omitempty"`
 }
-func (this *StatusRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Listener) Reset()      { *m = Listener{} }
+func (*Listener) ProtoMessage() {}
+func (*Listener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{16}
+}
+func (m *Listener) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Listener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Listener.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&StatusRequest{`,
-		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *StatusResponse) String() string {
-	if this == nil {
-		return "nil"
+func (dst *Listener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listener.Merge(dst, src)
+}
+func (m *Listener) XXX_Size() int {
+	return m.Size()
+}
+func (m *Listener) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listener proto.InternalMessageInfo
+
+func (m *Listener) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
 	}
-	s := strings.Join([]string{`&StatusResponse{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
-		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *UpdateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Listener) GetPid() *actor.PID {
+	if m != nil {
+		return m.Pid
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
-		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *PickupRequest) String() string {
-	if this == nil {
-		return "nil"
+
+type PendingCall struct {
+	Floor  uint32     `protobuf:"varint,1,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	Goal   uint32     `protobuf:"varint,2,opt,name=Goal,proto3" json:"Goal,omitempty"`
+	Sender *actor.PID `protobuf:"bytes,3,opt,name=Sender" json:"Sender,omitempty"`
+}
+
+func (m *PendingCall) Reset()      { *m = PendingCall{} }
+func (*PendingCall) ProtoMessage() {}
+func (*PendingCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{17}
+}
+func (m *PendingCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&PickupRequest{`,
-		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
-		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *StepRequest) String() string {
-	if this == nil {
-		return "nil"
+func (dst *PendingCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCall.Merge(dst, src)
+}
+func (m *PendingCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCall proto.InternalMessageInfo
+
+func (m *PendingCall) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
+	}
+	return 0
+}
+
+func (m *PendingCall) GetGoal() uint32 {
+	if m != nil {
+		return m.Goal
+	}
+	return 0
+}
+
+func (m *PendingCall) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+// A pickup the car has not reached yet, with the destinations of the
+// passengers waiting there
+type HallCall struct {
+	Floor        uint32   `protobuf:"varint,1,opt,name=Floor,proto3" json:"Floor,omitempty"`
+	State        int32    `protobuf:"varint,2,opt,name=State,proto3" json:"State,omitempty"`
+	Destinations []uint32 `protobuf:"varint,3,rep,packed,name=Destinations,proto3" json:"Destinations,omitempty"`
+}
+
+func (m *HallCall) Reset()      { *m = HallCall{} }
+func (*HallCall) ProtoMessage() {}
+func (*HallCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{18}
+}
+func (m *HallCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HallCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HallCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *HallCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HallCall.Merge(dst, src)
+}
+func (m *HallCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *HallCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_HallCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HallCall proto.InternalMessageInfo
+
+func (m *HallCall) GetFloor() uint32 {
+	if m != nil {
+		return m.Floor
+	}
+	return 0
+}
+
+func (m *HallCall) GetState() int32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *HallCall) GetDestinations() []uint32 {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// Told to every client when an elevator stops taking calls, the hall
+// calls it dropped are for the clients to hand to other cars
+type DrainEvent struct {
+	Id        uint32      `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	HallCalls []*HallCall `protobuf:"bytes,2,rep,name=HallCalls" json:"HallCalls,omitempty"`
+}
+
+func (m *DrainEvent) Reset()      { *m = DrainEvent{} }
+func (*DrainEvent) ProtoMessage() {}
+func (*DrainEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{19}
+}
+func (m *DrainEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DrainEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainEvent.Merge(dst, src)
+}
+func (m *DrainEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainEvent proto.InternalMessageInfo
+
+func (m *DrainEvent) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DrainEvent) GetHallCalls() []*HallCall {
+	if m != nil {
+		return m.HallCalls
+	}
+	return nil
+}
+
+// Told to every client when an elevator kept crashing and was taken out
+// of service, the hall calls are for the clients to hand to other cars
+type FaultEvent struct {
+	Id        uint32      `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Reason    string      `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Restarts  uint32      `protobuf:"varint,3,opt,name=Restarts,proto3" json:"Restarts,omitempty"`
+	HallCalls []*HallCall `protobuf:"bytes,4,rep,name=HallCalls" json:"HallCalls,omitempty"`
+}
+
+func (m *FaultEvent) Reset()      { *m = FaultEvent{} }
+func (*FaultEvent) ProtoMessage() {}
+func (*FaultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{20}
+}
+func (m *FaultEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FaultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FaultEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FaultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultEvent.Merge(dst, src)
+}
+func (m *FaultEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FaultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultEvent proto.InternalMessageInfo
+
+func (m *FaultEvent) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FaultEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *FaultEvent) GetHallCalls() []*HallCall {
+	if m != nil {
+		return m.HallCalls
+	}
+	return nil
+}
+
+// A command applied by an elevator since its last snapshot
+type WalEntry struct {
+	// Types that are valid to be assigned to Entry:
+	//	*WalEntry_Update
+	//	*WalEntry_Pickup
+	//	*WalEntry_DestinationPickup
+	//	*WalEntry_Cancel
+	//	*WalEntry_Batch
+	//	*WalEntry_Step
+	//	*WalEntry_MultiStep
+	Entry isWalEntry_Entry `protobuf_oneof:"Entry"`
+	Index uint64           `protobuf:"varint,8,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (m *WalEntry) Reset()      { *m = WalEntry{} }
+func (*WalEntry) ProtoMessage() {}
+func (*WalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{21}
+}
+func (m *WalEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WalEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *WalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalEntry.Merge(dst, src)
+}
+func (m *WalEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalEntry proto.InternalMessageInfo
+
+type isWalEntry_Entry interface {
+	isWalEntry_Entry()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type WalEntry_Update struct {
+	Update *UpdateRequest `protobuf:"bytes,1,opt,name=Update,oneof"`
+}
+type WalEntry_Pickup struct {
+	Pickup *PickupRequest `protobuf:"bytes,2,opt,name=Pickup,oneof"`
+}
+type WalEntry_DestinationPickup struct {
+	DestinationPickup *DestinationPickupRequest `protobuf:"bytes,3,opt,name=DestinationPickup,oneof"`
+}
+type WalEntry_Cancel struct {
+	Cancel *CancelRequest `protobuf:"bytes,4,opt,name=Cancel,oneof"`
+}
+type WalEntry_Batch struct {
+	Batch *BatchRequest `protobuf:"bytes,5,opt,name=Batch,oneof"`
+}
+type WalEntry_Step struct {
+	Step *StepRequest `protobuf:"bytes,6,opt,name=Step,oneof"`
+}
+type WalEntry_MultiStep struct {
+	MultiStep *MultiStepRequest `protobuf:"bytes,7,opt,name=MultiStep,oneof"`
+}
+
+func (*WalEntry_Update) isWalEntry_Entry()            {}
+func (*WalEntry_Pickup) isWalEntry_Entry()            {}
+func (*WalEntry_DestinationPickup) isWalEntry_Entry() {}
+func (*WalEntry_Cancel) isWalEntry_Entry()            {}
+func (*WalEntry_Batch) isWalEntry_Entry()             {}
+func (*WalEntry_Step) isWalEntry_Entry()              {}
+func (*WalEntry_MultiStep) isWalEntry_Entry()         {}
+
+func (m *WalEntry) GetEntry() isWalEntry_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *WalEntry) GetUpdate() *UpdateRequest {
+	if x, ok := m.GetEntry().(*WalEntry_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (m *WalEntry) GetPickup() *PickupRequest {
+	if x, ok := m.GetEntry().(*WalEntry_Pickup); ok {
+		return x.Pickup
+	}
+	return nil
+}
+
+func (m *WalEntry) GetDestinationPickup() *DestinationPickupRequest {
+	if x, ok := m.GetEntry().(*WalEntry_DestinationPickup); ok {
+		return x.DestinationPickup
+	}
+	return nil
+}
+
+func (m *WalEntry) GetCancel() *CancelRequest {
+	if x, ok := m.GetEntry().(*WalEntry_Cancel); ok {
+		return x.Cancel
+	}
+	return nil
+}
+
+func (m *WalEntry) GetBatch() *BatchRequest {
+	if x, ok := m.GetEntry().(*WalEntry_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (m *WalEntry) GetStep() *StepRequest {
+	if x, ok := m.GetEntry().(*WalEntry_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (m *WalEntry) GetMultiStep() *MultiStepRequest {
+	if x, ok := m.GetEntry().(*WalEntry_MultiStep); ok {
+		return x.MultiStep
+	}
+	return nil
+}
+
+func (m *WalEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*WalEntry) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _WalEntry_OneofMarshaler, _WalEntry_OneofUnmarshaler, _WalEntry_OneofSizer, []interface{}{
+		(*WalEntry_Update)(nil),
+		(*WalEntry_Pickup)(nil),
+		(*WalEntry_DestinationPickup)(nil),
+		(*WalEntry_Cancel)(nil),
+		(*WalEntry_Batch)(nil),
+		(*WalEntry_Step)(nil),
+		(*WalEntry_MultiStep)(nil),
+	}
+}
+
+func _WalEntry_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*WalEntry)
+	// Entry
+	switch x := m.Entry.(type) {
+	case *WalEntry_Update:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Update); err != nil {
+			return err
+		}
+	case *WalEntry_Pickup:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Pickup); err != nil {
+			return err
+		}
+	case *WalEntry_DestinationPickup:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DestinationPickup); err != nil {
+			return err
+		}
+	case *WalEntry_Cancel:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Cancel); err != nil {
+			return err
+		}
+	case *WalEntry_Batch:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Batch); err != nil {
+			return err
+		}
+	case *WalEntry_Step:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Step); err != nil {
+			return err
+		}
+	case *WalEntry_MultiStep:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultiStep); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("WalEntry.Entry has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _WalEntry_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*WalEntry)
+	switch tag {
+	case 1: // Entry.Update
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UpdateRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_Update{msg}
+		return true, err
+	case 2: // Entry.Pickup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PickupRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_Pickup{msg}
+		return true, err
+	case 3: // Entry.DestinationPickup
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DestinationPickupRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_DestinationPickup{msg}
+		return true, err
+	case 4: // Entry.Cancel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CancelRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_Cancel{msg}
+		return true, err
+	case 5: // Entry.Batch
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BatchRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_Batch{msg}
+		return true, err
+	case 6: // Entry.Step
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StepRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_Step{msg}
+		return true, err
+	case 7: // Entry.MultiStep
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiStepRequest)
+		err := b.DecodeMessage(msg)
+		m.Entry = &WalEntry_MultiStep{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _WalEntry_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*WalEntry)
+	// Entry
+	switch x := m.Entry.(type) {
+	case *WalEntry_Update:
+		s := proto.Size(x.Update)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_Pickup:
+		s := proto.Size(x.Pickup)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_DestinationPickup:
+		s := proto.Size(x.DestinationPickup)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_Cancel:
+		s := proto.Size(x.Cancel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_Batch:
+		s := proto.Size(x.Batch)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_Step:
+		s := proto.Size(x.Step)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *WalEntry_MultiStep:
+		s := proto.Size(x.MultiStep)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// A command in the journal of an elevator, see service/elevator/journal.go
+type JournalEntry struct {
+	Index uint64 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	// RFC 3339 time the command was applied at
+	Time      string     `protobuf:"bytes,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Sender    *actor.PID `protobuf:"bytes,3,opt,name=Sender" json:"Sender,omitempty"`
+	Principal string     `protobuf:"bytes,4,opt,name=Principal,proto3" json:"Principal,omitempty"`
+	// Command without the signature of its principal
+	Command *WalEntry `protobuf:"bytes,5,opt,name=Command" json:"Command,omitempty"`
+	Role    string    `protobuf:"bytes,6,opt,name=Role,proto3" json:"Role,omitempty"`
+	// State of the car as it started, set instead of Command. The commands
+	// after it apply to this state.
+	Base *Snapshot `protobuf:"bytes,7,opt,name=Base" json:"Base,omitempty"`
+}
+
+func (m *JournalEntry) Reset()      { *m = JournalEntry{} }
+func (*JournalEntry) ProtoMessage() {}
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_f1ff66d0f24ecedc, []int{22}
+}
+func (m *JournalEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JournalEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *JournalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalEntry.Merge(dst, src)
+}
+func (m *JournalEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalEntry proto.InternalMessageInfo
+
+func (m *JournalEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *JournalEntry) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *JournalEntry) GetSender() *actor.PID {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *JournalEntry) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *JournalEntry) GetCommand() *WalEntry {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *JournalEntry) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *JournalEntry) GetBase() *Snapshot {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Principal)(nil), "messages.Principal")
+	proto.RegisterType((*HelloRequest)(nil), "messages.HelloRequest")
+	proto.RegisterType((*HelloResponse)(nil), "messages.HelloResponse")
+	proto.RegisterType((*StatusRequest)(nil), "messages.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "messages.StatusResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "messages.UpdateRequest")
+	proto.RegisterType((*PickupRequest)(nil), "messages.PickupRequest")
+	proto.RegisterType((*DestinationPickupRequest)(nil), "messages.DestinationPickupRequest")
+	proto.RegisterType((*CancelRequest)(nil), "messages.CancelRequest")
+	proto.RegisterType((*Command)(nil), "messages.Command")
+	proto.RegisterType((*BatchRequest)(nil), "messages.BatchRequest")
+	proto.RegisterType((*StepRequest)(nil), "messages.StepRequest")
+	proto.RegisterType((*ArrivalEvent)(nil), "messages.ArrivalEvent")
+	proto.RegisterType((*MultiStepRequest)(nil), "messages.MultiStepRequest")
+	proto.RegisterType((*MultiStepResponse)(nil), "messages.MultiStepResponse")
+	proto.RegisterType((*Snapshot)(nil), "messages.Snapshot")
+	proto.RegisterType((*Listener)(nil), "messages.Listener")
+	proto.RegisterType((*PendingCall)(nil), "messages.PendingCall")
+	proto.RegisterType((*HallCall)(nil), "messages.HallCall")
+	proto.RegisterType((*DrainEvent)(nil), "messages.DrainEvent")
+	proto.RegisterType((*FaultEvent)(nil), "messages.FaultEvent")
+	proto.RegisterType((*WalEntry)(nil), "messages.WalEntry")
+	proto.RegisterType((*JournalEntry)(nil), "messages.JournalEntry")
+}
+func (this *Principal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Principal)
+	if !ok {
+		that2, ok := that.(Principal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if this.Expires != that1.Expires {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	return true
+}
+func (this *HelloRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HelloRequest)
+	if !ok {
+		that2, ok := that.(HelloRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Features) != len(that1.Features) {
+		return false
+	}
+	for i := range this.Features {
+		if this.Features[i] != that1.Features[i] {
+			return false
+		}
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *HelloResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HelloResponse)
+	if !ok {
+		that2, ok := that.(HelloResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Floors != that1.Floors {
+		return false
+	}
+	if len(this.ServedFloors) != len(that1.ServedFloors) {
+		return false
+	}
+	for i := range this.ServedFloors {
+		if this.ServedFloors[i] != that1.ServedFloors[i] {
+			return false
+		}
+	}
+	if len(this.Features) != len(that1.Features) {
+		return false
+	}
+	for i := range this.Features {
+		if this.Features[i] != that1.Features[i] {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *StatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatusRequest)
+	if !ok {
+		that2, ok := that.(StatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *StatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatusResponse)
+	if !ok {
+		that2, ok := that.(StatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.Goal != that1.Goal {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.Draining != that1.Draining {
+		return false
+	}
+	if this.Faulted != that1.Faulted {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *UpdateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateRequest)
+	if !ok {
+		that2, ok := that.(UpdateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Goal != that1.Goal {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *PickupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PickupRequest)
+	if !ok {
+		that2, ok := that.(PickupRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *DestinationPickupRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationPickupRequest)
+	if !ok {
+		that2, ok := that.(DestinationPickupRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *CancelRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelRequest)
+	if !ok {
+		that2, ok := that.(CancelRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *Command) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Command)
+	if !ok {
+		that2, ok := that.(Command)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Command == nil {
+		if this.Command != nil {
+			return false
+		}
+	} else if this.Command == nil {
+		return false
+	} else if !this.Command.Equal(that1.Command) {
+		return false
+	}
+	return true
+}
+func (this *Command_Update) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Command_Update)
+	if !ok {
+		that2, ok := that.(Command_Update)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	return true
+}
+func (this *Command_Pickup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Command_Pickup)
+	if !ok {
+		that2, ok := that.(Command_Pickup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pickup.Equal(that1.Pickup) {
+		return false
+	}
+	return true
+}
+func (this *BatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchRequest)
+	if !ok {
+		that2, ok := that.(BatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if len(this.Commands) != len(that1.Commands) {
+		return false
+	}
+	for i := range this.Commands {
+		if !this.Commands[i].Equal(that1.Commands[i]) {
+			return false
+		}
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *StepRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StepRequest)
+	if !ok {
+		that2, ok := that.(StepRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *ArrivalEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArrivalEvent)
+	if !ok {
+		that2, ok := that.(ArrivalEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Stop != that1.Stop {
+		return false
+	}
+	return true
+}
+func (this *MultiStepRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultiStepRequest)
+	if !ok {
+		that2, ok := that.(MultiStepRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.UntilIdle != that1.UntilIdle {
+		return false
+	}
+	if !this.Principal.Equal(that1.Principal) {
+		return false
+	}
+	if this.TraceParent != that1.TraceParent {
+		return false
+	}
+	if this.Request != that1.Request {
+		return false
+	}
+	return true
+}
+func (this *MultiStepResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultiStepResponse)
+	if !ok {
+		that2, ok := that.(MultiStepResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Status.Equal(that1.Status) {
+		return false
+	}
+	if this.Steps != that1.Steps {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Snapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Snapshot)
+	if !ok {
+		that2, ok := that.(Snapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BitVector != that1.BitVector {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.LockedPickupFloor != that1.LockedPickupFloor {
+		return false
+	}
+	if this.LockedDirection != that1.LockedDirection {
+		return false
+	}
+	if len(this.Listeners) != len(that1.Listeners) {
+		return false
+	}
+	for i := range this.Listeners {
+		if !this.Listeners[i].Equal(that1.Listeners[i]) {
+			return false
+		}
+	}
+	if len(this.PendingCalls) != len(that1.PendingCalls) {
+		return false
+	}
+	for i := range this.PendingCalls {
+		if !this.PendingCalls[i].Equal(that1.PendingCalls[i]) {
+			return false
+		}
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if len(this.HallCalls) != len(that1.HallCalls) {
+		return false
+	}
+	for i := range this.HallCalls {
+		if !this.HallCalls[i].Equal(that1.HallCalls[i]) {
+			return false
+		}
+	}
+	if this.CarCalls != that1.CarCalls {
+		return false
+	}
+	if len(this.Clients) != len(that1.Clients) {
+		return false
+	}
+	for i := range this.Clients {
+		if !this.Clients[i].Equal(that1.Clients[i]) {
+			return false
+		}
+	}
+	if this.Draining != that1.Draining {
+		return false
+	}
+	if this.Faulted != that1.Faulted {
+		return false
+	}
+	return true
+}
+func (this *Listener) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Listener)
+	if !ok {
+		that2, ok := that.(Listener)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if !this.Pid.Equal(that1.Pid) {
+		return false
+	}
+	return true
+}
+func (this *PendingCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingCall)
+	if !ok {
+		that2, ok := that.(PendingCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.Goal != that1.Goal {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	return true
+}
+func (this *HallCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HallCall)
+	if !ok {
+		that2, ok := that.(HallCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Floor != that1.Floor {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if len(this.Destinations) != len(that1.Destinations) {
+		return false
+	}
+	for i := range this.Destinations {
+		if this.Destinations[i] != that1.Destinations[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DrainEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainEvent)
+	if !ok {
+		that2, ok := that.(DrainEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if len(this.HallCalls) != len(that1.HallCalls) {
+		return false
+	}
+	for i := range this.HallCalls {
+		if !this.HallCalls[i].Equal(that1.HallCalls[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FaultEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FaultEvent)
+	if !ok {
+		that2, ok := that.(FaultEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Restarts != that1.Restarts {
+		return false
+	}
+	if len(this.HallCalls) != len(that1.HallCalls) {
+		return false
+	}
+	for i := range this.HallCalls {
+		if !this.HallCalls[i].Equal(that1.HallCalls[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WalEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry)
+	if !ok {
+		that2, ok := that.(WalEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Entry == nil {
+		if this.Entry != nil {
+			return false
+		}
+	} else if this.Entry == nil {
+		return false
+	} else if !this.Entry.Equal(that1.Entry) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_Update) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_Update)
+	if !ok {
+		that2, ok := that.(WalEntry_Update)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_Pickup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_Pickup)
+	if !ok {
+		that2, ok := that.(WalEntry_Pickup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pickup.Equal(that1.Pickup) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_DestinationPickup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_DestinationPickup)
+	if !ok {
+		that2, ok := that.(WalEntry_DestinationPickup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DestinationPickup.Equal(that1.DestinationPickup) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_Cancel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_Cancel)
+	if !ok {
+		that2, ok := that.(WalEntry_Cancel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cancel.Equal(that1.Cancel) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_Batch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_Batch)
+	if !ok {
+		that2, ok := that.(WalEntry_Batch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Batch.Equal(that1.Batch) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_Step) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_Step)
+	if !ok {
+		that2, ok := that.(WalEntry_Step)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Step.Equal(that1.Step) {
+		return false
+	}
+	return true
+}
+func (this *WalEntry_MultiStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WalEntry_MultiStep)
+	if !ok {
+		that2, ok := that.(WalEntry_MultiStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MultiStep.Equal(that1.MultiStep) {
+		return false
+	}
+	return true
+}
+func (this *JournalEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JournalEntry)
+	if !ok {
+		that2, ok := that.(JournalEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if this.Principal != that1.Principal {
+		return false
+	}
+	if !this.Command.Equal(that1.Command) {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !this.Base.Equal(that1.Base) {
+		return false
+	}
+	return true
+}
+func (this *Principal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.Principal{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HelloRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.HelloRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Features: "+fmt.Sprintf("%#v", this.Features)+",\n")
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HelloResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.HelloResponse{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Floors: "+fmt.Sprintf("%#v", this.Floors)+",\n")
+	s = append(s, "ServedFloors: "+fmt.Sprintf("%#v", this.ServedFloors)+",\n")
+	s = append(s, "Features: "+fmt.Sprintf("%#v", this.Features)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.StatusRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.StatusResponse{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "Goal: "+fmt.Sprintf("%#v", this.Goal)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
+	s = append(s, "Faulted: "+fmt.Sprintf("%#v", this.Faulted)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.UpdateRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Goal: "+fmt.Sprintf("%#v", this.Goal)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PickupRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.PickupRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DestinationPickupRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.DestinationPickupRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CancelRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.CancelRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Command) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.Command{")
+	if this.Command != nil {
+		s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Command_Update) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.Command_Update{` +
+		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
+	return s
+}
+func (this *Command_Pickup) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.Command_Pickup{` +
+		`Pickup:` + fmt.Sprintf("%#v", this.Pickup) + `}`}, ", ")
+	return s
+}
+func (this *BatchRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.BatchRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	if this.Commands != nil {
+		s = append(s, "Commands: "+fmt.Sprintf("%#v", this.Commands)+",\n")
+	}
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StepRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.StepRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ArrivalEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.ArrivalEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Stop: "+fmt.Sprintf("%#v", this.Stop)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultiStepRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.MultiStepRequest{")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "UntilIdle: "+fmt.Sprintf("%#v", this.UntilIdle)+",\n")
+	if this.Principal != nil {
+		s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	}
+	s = append(s, "TraceParent: "+fmt.Sprintf("%#v", this.TraceParent)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultiStepResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.MultiStepResponse{")
+	if this.Status != nil {
+		s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	}
+	s = append(s, "Steps: "+fmt.Sprintf("%#v", this.Steps)+",\n")
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Snapshot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&messages.Snapshot{")
+	s = append(s, "BitVector: "+fmt.Sprintf("%#v", this.BitVector)+",\n")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "LockedPickupFloor: "+fmt.Sprintf("%#v", this.LockedPickupFloor)+",\n")
+	s = append(s, "LockedDirection: "+fmt.Sprintf("%#v", this.LockedDirection)+",\n")
+	if this.Listeners != nil {
+		s = append(s, "Listeners: "+fmt.Sprintf("%#v", this.Listeners)+",\n")
+	}
+	if this.PendingCalls != nil {
+		s = append(s, "PendingCalls: "+fmt.Sprintf("%#v", this.PendingCalls)+",\n")
+	}
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	if this.HallCalls != nil {
+		s = append(s, "HallCalls: "+fmt.Sprintf("%#v", this.HallCalls)+",\n")
+	}
+	s = append(s, "CarCalls: "+fmt.Sprintf("%#v", this.CarCalls)+",\n")
+	if this.Clients != nil {
+		s = append(s, "Clients: "+fmt.Sprintf("%#v", this.Clients)+",\n")
+	}
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
+	s = append(s, "Faulted: "+fmt.Sprintf("%#v", this.Faulted)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Listener) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.Listener{")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	if this.Pid != nil {
+		s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingCall) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.PendingCall{")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "Goal: "+fmt.Sprintf("%#v", this.Goal)+",\n")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HallCall) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.HallCall{")
+	s = append(s, "Floor: "+fmt.Sprintf("%#v", this.Floor)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Destinations: "+fmt.Sprintf("%#v", this.Destinations)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.DrainEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.HallCalls != nil {
+		s = append(s, "HallCalls: "+fmt.Sprintf("%#v", this.HallCalls)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FaultEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.FaultEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Restarts: "+fmt.Sprintf("%#v", this.Restarts)+",\n")
+	if this.HallCalls != nil {
+		s = append(s, "HallCalls: "+fmt.Sprintf("%#v", this.HallCalls)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WalEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.WalEntry{")
+	if this.Entry != nil {
+		s = append(s, "Entry: "+fmt.Sprintf("%#v", this.Entry)+",\n")
+	}
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WalEntry_Update) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_Update{` +
+		`Update:` + fmt.Sprintf("%#v", this.Update) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_Pickup) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_Pickup{` +
+		`Pickup:` + fmt.Sprintf("%#v", this.Pickup) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_DestinationPickup) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_DestinationPickup{` +
+		`DestinationPickup:` + fmt.Sprintf("%#v", this.DestinationPickup) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_Cancel) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_Cancel{` +
+		`Cancel:` + fmt.Sprintf("%#v", this.Cancel) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_Batch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_Batch{` +
+		`Batch:` + fmt.Sprintf("%#v", this.Batch) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_Step) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_Step{` +
+		`Step:` + fmt.Sprintf("%#v", this.Step) + `}`}, ", ")
+	return s
+}
+func (this *WalEntry_MultiStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&messages.WalEntry_MultiStep{` +
+		`MultiStep:` + fmt.Sprintf("%#v", this.MultiStep) + `}`}, ", ")
+	return s
+}
+func (this *JournalEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.JournalEntry{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	s = append(s, "Principal: "+fmt.Sprintf("%#v", this.Principal)+",\n")
+	if this.Command != nil {
+		s = append(s, "Command: "+fmt.Sprintf("%#v", this.Command)+",\n")
+	}
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	if this.Base != nil {
+		s = append(s, "Base: "+fmt.Sprintf("%#v", this.Base)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessages(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Principal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Principal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Expires))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Proof) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Proof)))
+		i += copy(dAtA[i:], m.Proof)
+	}
+	return i, nil
+}
+
+func (m *HelloRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelloRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n1, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *HelloResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelloResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Version))
+	}
+	if m.Floors != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floors))
+	}
+	if len(m.ServedFloors) > 0 {
+		dAtA3 := make([]byte, len(m.ServedFloors)*10)
+		var j2 int
+		for _, num := range m.ServedFloors {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(j2))
+		i += copy(dAtA[i:], dAtA3[:j2])
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n4, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.Goal != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Goal))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Draining {
+		dAtA[i] = 0x30
+		i++
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Faulted {
+		dAtA[i] = 0x38
+		i++
+		if m.Faulted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n5, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Goal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Goal))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n6, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *PickupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PickupRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n7, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n8, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *DestinationPickupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationPickupRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n9, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.Destination != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Destination))
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n10, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *CancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n11, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n12, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *Command) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Command) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Command != nil {
+		nn13, err := m.Command.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn13
+	}
+	return i, nil
+}
+
+func (m *Command_Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Update != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Update.Size()))
+		n14, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *Command_Pickup) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Pickup != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Pickup.Size()))
+		n15, err := m.Pickup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n16, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.Commands) > 0 {
+		for _, msg := range m.Commands {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n17, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *StepRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n18, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n19, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *ArrivalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArrivalEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if m.Stop != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Stop))
+	}
+	return i, nil
+}
+
+func (m *MultiStepRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStepRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sender != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n20, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Count))
+	}
+	if m.UntilIdle {
+		dAtA[i] = 0x18
+		i++
+		if m.UntilIdle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Principal != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Principal.Size()))
+		n21, err := m.Principal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	if m.Request != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Request))
+	}
+	return i, nil
+}
+
+func (m *MultiStepResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStepResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Status.Size()))
+		n22, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.Steps != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Steps))
+	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BitVector != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.BitVector))
+	}
+	if m.Floor != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if m.LockedPickupFloor != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.LockedPickupFloor))
+	}
+	if m.LockedDirection != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.LockedDirection))
+	}
+	if len(m.Listeners) > 0 {
+		for _, msg := range m.Listeners {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PendingCalls) > 0 {
+		for _, msg := range m.PendingCalls {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Index))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, msg := range m.HallCalls {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CarCalls != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.CarCalls))
+	}
+	if len(m.Clients) > 0 {
+		for _, msg := range m.Clients {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Draining {
+		dAtA[i] = 0x60
+		i++
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Faulted {
+		dAtA[i] = 0x68
+		i++
+		if m.Faulted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Listener) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Listener) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.Pid != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Pid.Size()))
+		n23, err := m.Pid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *PendingCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCall) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.Goal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Goal))
+	}
+	if m.Sender != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n24, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+
+func (m *HallCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HallCall) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Floor))
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.State))
+	}
+	if len(m.Destinations) > 0 {
+		dAtA26 := make([]byte, len(m.Destinations)*10)
+		var j25 int
+		for _, num := range m.Destinations {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(j25))
+		i += copy(dAtA[i:], dAtA26[:j25])
+	}
+	return i, nil
+}
+
+func (m *DrainEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, msg := range m.HallCalls {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *FaultEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FaultEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Id))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.Restarts != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Restarts))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, msg := range m.HallCalls {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		nn27, err := m.Entry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn27
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
+func (m *WalEntry_Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Update != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Update.Size()))
+		n28, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *WalEntry_Pickup) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Pickup != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Pickup.Size()))
+		n29, err := m.Pickup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+func (m *WalEntry_DestinationPickup) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DestinationPickup != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.DestinationPickup.Size()))
+		n30, err := m.DestinationPickup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+func (m *WalEntry_Cancel) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Cancel != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Cancel.Size()))
+		n31, err := m.Cancel.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *WalEntry_Batch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Batch != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Batch.Size()))
+		n32, err := m.Batch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+func (m *WalEntry_Step) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Step != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Step.Size()))
+		n33, err := m.Step.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+func (m *WalEntry_MultiStep) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultiStep != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.MultiStep.Size()))
+		n34, err := m.MultiStep.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+func (m *JournalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Time) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Time)))
+		i += copy(dAtA[i:], m.Time)
+	}
+	if m.Sender != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Sender.Size()))
+		n35, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if len(m.Principal) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Principal)))
+		i += copy(dAtA[i:], m.Principal)
+	}
+	if m.Command != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Command.Size()))
+		n36, err := m.Command.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.Role) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Role)))
+		i += copy(dAtA[i:], m.Role)
+	}
+	if m.Base != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Base.Size()))
+		n37, err := m.Base.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	return i, nil
+}
+
+func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Principal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovMessages(uint64(m.Expires))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovMessages(uint64(m.Time))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *HelloRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessages(uint64(m.Version))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *HelloResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessages(uint64(m.Id))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessages(uint64(m.Version))
+	}
+	if m.Floors != 0 {
+		n += 1 + sovMessages(uint64(m.Floors))
+	}
+	if len(m.ServedFloors) > 0 {
+		l = 0
+		for _, e := range m.ServedFloors {
+			l += sovMessages(uint64(e))
+		}
+		n += 1 + sovMessages(uint64(l)) + l
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessages(uint64(m.Id))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.Goal != 0 {
+		n += 1 + sovMessages(uint64(m.Goal))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.Faulted {
+		n += 2
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Goal != 0 {
+		n += 1 + sovMessages(uint64(m.Goal))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *PickupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *DestinationPickupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovMessages(uint64(m.Destination))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *CancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *Command) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Command != nil {
+		n += m.Command.Size()
+	}
+	return n
+}
+
+func (m *Command_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *Command_Pickup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pickup != nil {
+		l = m.Pickup.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *BatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if len(m.Commands) > 0 {
+		for _, e := range m.Commands {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *StepRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *ArrivalEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessages(uint64(m.Id))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	if m.Stop != 0 {
+		n += 1 + sovMessages(uint64(m.Stop))
+	}
+	return n
+}
+
+func (m *MultiStepRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessages(uint64(m.Count))
+	}
+	if m.UntilIdle {
+		n += 2
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Request != 0 {
+		n += 1 + sovMessages(uint64(m.Request))
+	}
+	return n
+}
+
+func (m *MultiStepResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Steps != 0 {
+		n += 1 + sovMessages(uint64(m.Steps))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitVector != 0 {
+		n += 1 + sovMessages(uint64(m.BitVector))
+	}
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	if m.LockedPickupFloor != 0 {
+		n += 1 + sovMessages(uint64(m.LockedPickupFloor))
+	}
+	if m.LockedDirection != 0 {
+		n += 1 + sovMessages(uint64(m.LockedDirection))
+	}
+	if len(m.Listeners) > 0 {
+		for _, e := range m.Listeners {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.PendingCalls) > 0 {
+		for _, e := range m.PendingCalls {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Index != 0 {
+		n += 1 + sovMessages(uint64(m.Index))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, e := range m.HallCalls {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.CarCalls != 0 {
+		n += 1 + sovMessages(uint64(m.CarCalls))
+	}
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.Faulted {
+		n += 2
+	}
+	return n
+}
+
+func (m *Listener) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.Pid != nil {
+		l = m.Pid.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.Goal != 0 {
+		n += 1 + sovMessages(uint64(m.Goal))
+	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *HallCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Floor != 0 {
+		n += 1 + sovMessages(uint64(m.Floor))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessages(uint64(m.State))
+	}
+	if len(m.Destinations) > 0 {
+		l = 0
+		for _, e := range m.Destinations {
+			l += sovMessages(uint64(e))
+		}
+		n += 1 + sovMessages(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DrainEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessages(uint64(m.Id))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, e := range m.HallCalls {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FaultEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMessages(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovMessages(uint64(m.Restarts))
+	}
+	if len(m.HallCalls) > 0 {
+		for _, e := range m.HallCalls {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		n += m.Entry.Size()
+	}
+	if m.Index != 0 {
+		n += 1 + sovMessages(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *WalEntry_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_Pickup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pickup != nil {
+		l = m.Pickup.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_DestinationPickup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationPickup != nil {
+		l = m.DestinationPickup.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_Cancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancel != nil {
+		l = m.Cancel.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_Step) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Step != nil {
+		l = m.Step.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *WalEntry_MultiStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultiStep != nil {
+		l = m.MultiStep.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+func (m *JournalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMessages(uint64(m.Index))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Command != nil {
+		l = m.Command.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func sovMessages(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMessages(x uint64) (n int) {
+	return sovMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Principal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Principal{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelloRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelloRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Features:` + fmt.Sprintf("%v", this.Features) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelloResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelloResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Floors:` + fmt.Sprintf("%v", this.Floors) + `,`,
+		`ServedFloors:` + fmt.Sprintf("%v", this.ServedFloors) + `,`,
+		`Features:` + fmt.Sprintf("%v", this.Features) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatusRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatusResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`Faulted:` + fmt.Sprintf("%v", this.Faulted) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PickupRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PickupRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestinationPickupRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestinationPickupRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Command) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Command{`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Command_Update) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Command_Update{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Command_Pickup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Command_Pickup{`,
+		`Pickup:` + strings.Replace(fmt.Sprintf("%v", this.Pickup), "PickupRequest", "PickupRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Commands:` + strings.Replace(fmt.Sprintf("%v", this.Commands), "Command", "Command", 1) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArrivalEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArrivalEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Stop:` + fmt.Sprintf("%v", this.Stop) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiStepRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiStepRequest{`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`UntilIdle:` + fmt.Sprintf("%v", this.UntilIdle) + `,`,
+		`Principal:` + strings.Replace(fmt.Sprintf("%v", this.Principal), "Principal", "Principal", 1) + `,`,
+		`TraceParent:` + fmt.Sprintf("%v", this.TraceParent) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiStepResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiStepResponse{`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "StatusResponse", "StatusResponse", 1) + `,`,
+		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "ArrivalEvent", "ArrivalEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Snapshot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Snapshot{`,
+		`BitVector:` + fmt.Sprintf("%v", this.BitVector) + `,`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`LockedPickupFloor:` + fmt.Sprintf("%v", this.LockedPickupFloor) + `,`,
+		`LockedDirection:` + fmt.Sprintf("%v", this.LockedDirection) + `,`,
+		`Listeners:` + strings.Replace(fmt.Sprintf("%v", this.Listeners), "Listener", "Listener", 1) + `,`,
+		`PendingCalls:` + strings.Replace(fmt.Sprintf("%v", this.PendingCalls), "PendingCall", "PendingCall", 1) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`HallCalls:` + strings.Replace(fmt.Sprintf("%v", this.HallCalls), "HallCall", "HallCall", 1) + `,`,
+		`CarCalls:` + fmt.Sprintf("%v", this.CarCalls) + `,`,
+		`Clients:` + strings.Replace(fmt.Sprintf("%v", this.Clients), "PID", "actor.PID", 1) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`Faulted:` + fmt.Sprintf("%v", this.Faulted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Listener) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Listener{`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`Pid:` + strings.Replace(fmt.Sprintf("%v", this.Pid), "PID", "actor.PID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingCall) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingCall{`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HallCall) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HallCall{`,
+		`Floor:` + fmt.Sprintf("%v", this.Floor) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Destinations:` + fmt.Sprintf("%v", this.Destinations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`HallCalls:` + strings.Replace(fmt.Sprintf("%v", this.HallCalls), "HallCall", "HallCall", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FaultEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FaultEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Restarts:` + fmt.Sprintf("%v", this.Restarts) + `,`,
+		`HallCalls:` + strings.Replace(fmt.Sprintf("%v", this.HallCalls), "HallCall", "HallCall", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry{`,
+		`Entry:` + fmt.Sprintf("%v", this.Entry) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_Update) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_Update{`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_Pickup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_Pickup{`,
+		`Pickup:` + strings.Replace(fmt.Sprintf("%v", this.Pickup), "PickupRequest", "PickupRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_DestinationPickup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_DestinationPickup{`,
+		`DestinationPickup:` + strings.Replace(fmt.Sprintf("%v", this.DestinationPickup), "DestinationPickupRequest", "DestinationPickupRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_Cancel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_Cancel{`,
+		`Cancel:` + strings.Replace(fmt.Sprintf("%v", this.Cancel), "CancelRequest", "CancelRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_Batch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_Batch{`,
+		`Batch:` + strings.Replace(fmt.Sprintf("%v", this.Batch), "BatchRequest", "BatchRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_Step) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_Step{`,
+		`Step:` + strings.Replace(fmt.Sprintf("%v", this.Step), "StepRequest", "StepRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WalEntry_MultiStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WalEntry_MultiStep{`,
+		`MultiStep:` + strings.Replace(fmt.Sprintf("%v", this.MultiStep), "MultiStepRequest", "MultiStepRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JournalEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JournalEntry{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
+		`Principal:` + fmt.Sprintf("%v", this.Principal) + `,`,
+		`Command:` + strings.Replace(fmt.Sprintf("%v", this.Command), "WalEntry", "WalEntry", 1) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Base:` + strings.Replace(fmt.Sprintf("%v", this.Base), "Snapshot", "Snapshot", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessages(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Principal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Principal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Principal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelloRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelloRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelloRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelloResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelloResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelloResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floors", wireType)
+			}
+			m.Floors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floors |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ServedFloors = append(m.ServedFloors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessages
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ServedFloors) == 0 {
+					m.ServedFloors = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ServedFloors = append(m.ServedFloors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServedFloors", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+			}
+			m.Goal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Goal |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faulted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Faulted = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+			}
+			m.Goal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Goal |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PickupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PickupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PickupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationPickupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationPickupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationPickupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &Command_Update{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pickup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PickupRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &Command_Pickup{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, &Command{})
+			if err := m.Commands[len(m.Commands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArrivalEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArrivalEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArrivalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			m.Stop = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stop |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStepRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStepRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilIdle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UntilIdle = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			m.Request = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStepResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStepResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStepResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &StatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			m.Steps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Steps |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &ArrivalEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitVector", wireType)
+			}
+			m.BitVector = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitVector |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedPickupFloor", wireType)
+			}
+			m.LockedPickupFloor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedPickupFloor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedDirection", wireType)
+			}
+			m.LockedDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedDirection |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listeners = append(m.Listeners, &Listener{})
+			if err := m.Listeners[len(m.Listeners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCalls = append(m.PendingCalls, &PendingCall{})
+			if err := m.PendingCalls[len(m.PendingCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HallCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HallCalls = append(m.HallCalls, &HallCall{})
+			if err := m.HallCalls[len(m.HallCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarCalls", wireType)
+			}
+			m.CarCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CarCalls |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, &actor.PID{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faulted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Faulted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Listener) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Listener: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Listener: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pid == nil {
+				m.Pid = &actor.PID{}
+			}
+			if err := m.Pid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+			}
+			m.Goal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Goal |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &actor.PID{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HallCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HallCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HallCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			m.Floor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Floor |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Destinations = append(m.Destinations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessages
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessages
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Destinations) == 0 {
+					m.Destinations = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessages
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Destinations = append(m.Destinations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&StepRequest{`,
-		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringMessages(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DrainEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,15 +9765,34 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HallCalls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -913,10 +9816,8 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &actor.PID{}
-			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HallCalls = append(m.HallCalls, &HallCall{})
+			if err := m.HallCalls[len(m.HallCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -941,7 +9842,7 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+func (m *FaultEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -964,10 +9865,10 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FaultEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FaultEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -990,10 +9891,203 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
 			}
-			m.Floor = 0
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HallCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HallCalls = append(m.HallCalls, &HallCall{})
+			if err := m.HallCalls[len(m.HallCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entry = &WalEntry_Update{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pickup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PickupRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entry = &WalEntry_Pickup{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPickup", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1003,16 +10097,29 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Floor |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.Goal = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DestinationPickupRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entry = &WalEntry_DestinationPickup{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1022,16 +10129,29 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Goal |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.State = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entry = &WalEntry_Cancel{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1041,64 +10161,27 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessages
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &BatchRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Entry = &WalEntry_Batch{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1122,18 +10205,17 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &actor.PID{}
-			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &StepRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Entry = &WalEntry_Step{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiStep", wireType)
 			}
-			m.Goal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1143,16 +10225,29 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Goal |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MultiStepRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Entry = &WalEntry_MultiStep{v}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.State = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1162,7 +10257,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (int32(b) & 0x7F) << shift
+				m.Index |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1188,7 +10283,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PickupRequest) Unmarshal(dAtA []byte) error {
+func (m *JournalEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,13 +10306,61 @@ func (m *PickupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PickupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JournalEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PickupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JournalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1250,11 +10393,11 @@ func (m *PickupRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			m.Floor = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1264,16 +10407,26 @@ func (m *PickupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Floor |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
 			}
-			m.State = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -1283,64 +10436,57 @@ func (m *PickupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessages(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StepRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessages
+			if m.Command == nil {
+				m.Command = &WalEntry{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Command.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StepRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1364,10 +10510,10 @@ func (m *StepRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sender == nil {
-				m.Sender = &actor.PID{}
+			if m.Base == nil {
+				m.Base = &Snapshot{}
 			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1497,28 +10643,91 @@ var (
 	ErrIntOverflowMessages   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("messages.proto", fileDescriptor_messages_4c66d86304e563a1) }
-
-var fileDescriptor_messages_4c66d86304e563a1 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcb, 0x4d, 0x2d, 0x2e,
-	0x4e, 0x4c, 0x4f, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x80, 0xf1, 0xa5, 0xcc,
-	0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x1d, 0x8b, 0x2b, 0xf3, 0xb2,
-	0x8b, 0xf2, 0xf3, 0x3c, 0x43, 0xf4, 0xc1, 0xca, 0x12, 0x93, 0x4b, 0xf2, 0x8b, 0x74, 0xd3, 0xf3,
-	0xf5, 0xc1, 0x0c, 0x88, 0x18, 0xd4, 0x04, 0x25, 0x63, 0x2e, 0xde, 0xe0, 0x92, 0xc4, 0x92, 0xd2,
-	0xe2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x21, 0x25, 0x2e, 0xb6, 0xe0, 0xd4, 0xbc, 0x94,
-	0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x2e, 0x3d, 0xb0, 0x2e, 0xbd, 0x00, 0x4f,
-	0x97, 0x20, 0xa8, 0x8c, 0x52, 0x02, 0x17, 0x1f, 0x4c, 0x53, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x10, 0x1f, 0x17, 0x93, 0x67, 0x0a, 0x58, 0x07, 0x6f, 0x10, 0x93, 0x67, 0x8a, 0x90, 0x08, 0x17,
-	0xab, 0x5b, 0x4e, 0x7e, 0x7e, 0x91, 0x04, 0x13, 0x58, 0x08, 0xc2, 0x11, 0x12, 0xe2, 0x62, 0x71,
-	0xcf, 0x4f, 0xcc, 0x91, 0x60, 0x56, 0x60, 0xd4, 0x60, 0x0d, 0x02, 0xb3, 0x41, 0x2a, 0x41, 0x66,
-	0xa5, 0x4a, 0xb0, 0x80, 0x05, 0x21, 0x1c, 0xa5, 0x58, 0x2e, 0xde, 0xd0, 0x82, 0x94, 0xc4, 0x92,
-	0x54, 0x12, 0x9c, 0x05, 0x37, 0x1e, 0x62, 0x27, 0x9a, 0xf1, 0xcc, 0xc8, 0xc6, 0xc7, 0x73, 0xf1,
-	0x06, 0x64, 0x26, 0x67, 0x97, 0x16, 0x90, 0x62, 0x3c, 0x76, 0x3f, 0x61, 0xb7, 0xc0, 0x90, 0x8b,
-	0x3b, 0xb8, 0x24, 0x95, 0x14, 0xe3, 0x9d, 0x4c, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1, 0x50, 0x8e,
-	0xe1, 0xc3, 0x43, 0x39, 0xc6, 0x86, 0x47, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x24,
-	0xb1, 0x81, 0xa3, 0xd1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xdf, 0xa9, 0x04, 0x1a, 0x02,
-	0x00, 0x00,
+func init() { proto.RegisterFile("messages.proto", fileDescriptor_messages_f1ff66d0f24ecedc) }
+
+var fileDescriptor_messages_f1ff66d0f24ecedc = []byte{
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x8f, 0x1b, 0xc5,
+	0x13, 0x77, 0x7b, 0x3c, 0x7e, 0x94, 0xed, 0xfc, 0xb3, 0xfd, 0x5f, 0xc2, 0x68, 0x15, 0x8d, 0xac,
+	0x11, 0x42, 0x96, 0x48, 0x9c, 0x64, 0x41, 0x48, 0x70, 0x40, 0xca, 0x3e, 0x82, 0x17, 0x42, 0x64,
+	0xb5, 0x93, 0x20, 0x81, 0x84, 0xd4, 0xf1, 0x34, 0x9b, 0x51, 0x66, 0xa7, 0x4d, 0xcf, 0x38, 0x4a,
+	0x38, 0x21, 0xae, 0x5c, 0xf8, 0x08, 0x1c, 0x90, 0xe0, 0xc6, 0x95, 0x2b, 0x17, 0xc4, 0x01, 0xa1,
+	0x3d, 0x26, 0x37, 0xe2, 0x80, 0xc4, 0x31, 0x1f, 0x01, 0xf5, 0x63, 0x5e, 0xce, 0x7a, 0xb5, 0x0e,
+	0x68, 0xc5, 0xad, 0xab, 0xba, 0xba, 0xbb, 0x7e, 0xbf, 0xea, 0xae, 0xaa, 0x19, 0x38, 0x73, 0xc0,
+	0xe2, 0x98, 0xee, 0xb3, 0x78, 0x30, 0x15, 0x3c, 0xe1, 0xb8, 0x99, 0xca, 0x1b, 0x6f, 0xee, 0x07,
+	0xc9, 0xdd, 0xd9, 0x9d, 0xc1, 0x84, 0x1f, 0x5c, 0xba, 0x1a, 0x3f, 0x8c, 0xee, 0x09, 0x1e, 0xed,
+	0xdd, 0xbc, 0xa4, 0xcc, 0xe8, 0x24, 0xe1, 0xe2, 0xe2, 0x3e, 0xbf, 0xa4, 0x06, 0x5a, 0x67, 0x76,
+	0xf0, 0xbe, 0x43, 0xd0, 0x1a, 0x89, 0x20, 0x9a, 0x04, 0x53, 0x1a, 0x62, 0x0c, 0xb5, 0x1b, 0xf4,
+	0x80, 0x39, 0xa8, 0x87, 0xfa, 0x2d, 0xa2, 0xc6, 0x52, 0x47, 0x78, 0xc8, 0x9c, 0xaa, 0xd6, 0xc9,
+	0x31, 0x3e, 0x0f, 0xad, 0x71, 0xb0, 0x1f, 0xd1, 0x64, 0x26, 0x98, 0x63, 0xf5, 0x50, 0xbf, 0x43,
+	0x72, 0x05, 0x76, 0xa0, 0xb1, 0xfb, 0x60, 0x1a, 0x08, 0x16, 0x3b, 0xb5, 0x1e, 0xea, 0x5b, 0x24,
+	0x15, 0xf1, 0x59, 0xb0, 0xde, 0x67, 0x0f, 0x1d, 0x5b, 0xad, 0x90, 0x43, 0xb9, 0xfb, 0xcd, 0xe0,
+	0x80, 0x39, 0x75, 0x65, 0xa8, 0xc6, 0x78, 0x1d, 0xec, 0x91, 0xe0, 0xfc, 0x53, 0xa7, 0xa1, 0xec,
+	0xb4, 0xe0, 0x7d, 0x8b, 0xa0, 0x33, 0x64, 0x61, 0xc8, 0x09, 0xfb, 0x6c, 0xc6, 0xe2, 0x04, 0x7b,
+	0x50, 0x1f, 0xb3, 0xc8, 0x67, 0x42, 0xb9, 0xdb, 0xde, 0x84, 0x81, 0xc2, 0x37, 0x18, 0xed, 0xed,
+	0x10, 0x33, 0x23, 0x5d, 0xb9, 0xcd, 0x44, 0x1c, 0xf0, 0x48, 0xf9, 0xdf, 0x25, 0xa9, 0x88, 0x37,
+	0xa0, 0x79, 0x8d, 0x29, 0x7f, 0x63, 0xc7, 0xea, 0x59, 0xfd, 0x16, 0xc9, 0x64, 0xdc, 0x83, 0xf6,
+	0x4d, 0x41, 0x27, 0x6c, 0x44, 0x05, 0x8b, 0x12, 0x05, 0xa2, 0x45, 0x8a, 0x2a, 0xb9, 0xaf, 0x71,
+	0x43, 0x81, 0xa9, 0x91, 0x54, 0xf4, 0x7e, 0x42, 0xd0, 0x35, 0x6e, 0xc6, 0x53, 0x1e, 0xc5, 0x0c,
+	0x9f, 0x81, 0xea, 0x9e, 0xaf, 0x7c, 0xec, 0x92, 0xea, 0x9e, 0x7f, 0x8c, 0x4f, 0xe7, 0xa0, 0x7e,
+	0x2d, 0xe4, 0x5c, 0xc4, 0x8a, 0xd3, 0x2e, 0x31, 0x12, 0xf6, 0xa0, 0x33, 0x66, 0xe2, 0x3e, 0xf3,
+	0xcd, 0x6c, 0xad, 0x67, 0xf5, 0xbb, 0xa4, 0xa4, 0x2b, 0xe1, 0xb1, 0x17, 0xf0, 0xac, 0x83, 0xbd,
+	0x2b, 0x04, 0x17, 0x8a, 0xe5, 0x16, 0xd1, 0x42, 0x11, 0x43, 0xa3, 0x8c, 0x81, 0x43, 0x77, 0x9c,
+	0xd0, 0x64, 0x16, 0xaf, 0x42, 0xf5, 0x02, 0x69, 0xd5, 0x63, 0x49, 0xb3, 0xca, 0x07, 0xfe, 0x8c,
+	0xe0, 0x4c, 0x7a, 0xe2, 0x12, 0xd6, 0xd6, 0xc1, 0x56, 0x48, 0x0d, 0x67, 0x5a, 0x90, 0xd7, 0xe7,
+	0x5d, 0x4e, 0x43, 0xb5, 0x9f, 0x4d, 0xd4, 0x58, 0x5a, 0xca, 0xbd, 0x98, 0x8a, 0x9b, 0x4d, 0xb4,
+	0x90, 0x73, 0x60, 0x17, 0x39, 0xd8, 0x80, 0xe6, 0x8e, 0xa0, 0x41, 0x14, 0x44, 0xfb, 0x8a, 0x9c,
+	0x26, 0xc9, 0x64, 0xe9, 0xee, 0x35, 0x3a, 0x0b, 0x13, 0xe6, 0x2b, 0x7e, 0x9a, 0x24, 0x15, 0x8b,
+	0x40, 0x9a, 0x65, 0x20, 0xbf, 0x22, 0xe8, 0xde, 0x9a, 0xfa, 0x34, 0x61, 0xab, 0x50, 0x97, 0xa2,
+	0xd0, 0xd0, 0x16, 0x50, 0x58, 0x45, 0x14, 0x57, 0x0a, 0xaf, 0x55, 0xe1, 0x6b, 0x6f, 0xfe, 0x7f,
+	0x90, 0x25, 0x85, 0x6c, 0x8a, 0xe4, 0x56, 0x8b, 0x71, 0xb1, 0x8f, 0x8d, 0x4b, 0xbd, 0x0c, 0xe7,
+	0x37, 0x04, 0xdd, 0x51, 0x30, 0xb9, 0x37, 0x9b, 0xae, 0x02, 0xe7, 0xe8, 0x50, 0xfd, 0x27, 0x00,
+	0xfd, 0x89, 0xc0, 0xd9, 0x61, 0x71, 0x12, 0x44, 0x34, 0x09, 0x78, 0xf4, 0x6f, 0x61, 0xeb, 0x41,
+	0xbb, 0xb0, 0xab, 0x79, 0xbd, 0x45, 0xd5, 0x69, 0xe3, 0xfc, 0x11, 0x41, 0x77, 0x9b, 0x46, 0x13,
+	0x16, 0xfe, 0x73, 0x70, 0x25, 0xd7, 0xad, 0x17, 0x71, 0x7d, 0xa5, 0x04, 0xfa, 0x39, 0x34, 0xb6,
+	0xf9, 0xc1, 0x01, 0x8d, 0x7c, 0x7c, 0x05, 0xea, 0xfa, 0x31, 0x19, 0x9f, 0x5f, 0xce, 0x8f, 0x2d,
+	0x3d, 0xb2, 0x61, 0x85, 0x18, 0x43, 0xb9, 0x44, 0x07, 0xd5, 0xa9, 0x2e, 0x2e, 0x29, 0x05, 0x5b,
+	0x2e, 0xd1, 0x8a, 0xad, 0x56, 0x76, 0xa0, 0x77, 0x88, 0xa0, 0xb3, 0x45, 0x93, 0xc9, 0xdd, 0x55,
+	0x58, 0xbb, 0x08, 0x4d, 0xb3, 0x3e, 0x76, 0xaa, 0x3d, 0xab, 0xdf, 0xde, 0x5c, 0xcb, 0x0f, 0x35,
+	0x33, 0x24, 0x33, 0x39, 0x6d, 0x3a, 0xbf, 0x41, 0xd0, 0x1e, 0x27, 0x6c, 0xa5, 0x4b, 0x5e, 0x72,
+	0xb1, 0xfa, 0x22, 0x2e, 0x5a, 0xc7, 0xba, 0x58, 0x2b, 0xbb, 0xf8, 0x09, 0x74, 0xae, 0x0a, 0x11,
+	0xdc, 0xa7, 0xe1, 0xee, 0x7d, 0x69, 0x79, 0xb2, 0xd4, 0x7f, 0x74, 0x3e, 0xc1, 0x50, 0x1b, 0x27,
+	0x7c, 0x6a, 0x8e, 0x50, 0x63, 0xef, 0x31, 0x82, 0xb3, 0x1f, 0xcc, 0xc2, 0x24, 0x58, 0x95, 0x87,
+	0x75, 0xb0, 0xb7, 0xf9, 0xcc, 0x14, 0xb3, 0x2e, 0xd1, 0x82, 0x6c, 0x7e, 0x6e, 0x45, 0x49, 0x10,
+	0xee, 0xf9, 0xa1, 0x3e, 0xbc, 0x49, 0x72, 0xc5, 0x69, 0x3f, 0xf4, 0xaf, 0x10, 0xac, 0x15, 0xb0,
+	0x99, 0xe2, 0x79, 0x19, 0xea, 0xba, 0x9c, 0x1a, 0x70, 0x4e, 0xee, 0x41, 0xb9, 0xcc, 0x12, 0x63,
+	0xa7, 0xd9, 0x64, 0xd3, 0x38, 0x85, 0xaa, 0x04, 0x3c, 0x80, 0xba, 0x0a, 0x89, 0x6e, 0x91, 0xda,
+	0x9b, 0xe7, 0xf2, 0x7d, 0x8a, 0x11, 0x23, 0xc6, 0xca, 0x7b, 0x6c, 0x41, 0x73, 0x1c, 0xd1, 0x69,
+	0x7c, 0x97, 0x2b, 0x9e, 0xb6, 0x82, 0xe4, 0x36, 0x93, 0xb4, 0x9a, 0x68, 0xe6, 0x8a, 0x95, 0x82,
+	0x7a, 0x01, 0xd6, 0xae, 0xf3, 0xc9, 0x3d, 0xe6, 0xeb, 0x17, 0xab, 0xd7, 0xd5, 0xd4, 0xba, 0xe7,
+	0x27, 0x70, 0x1f, 0xfe, 0xa7, 0x95, 0x3b, 0x81, 0x60, 0x13, 0x95, 0x90, 0x6d, 0xb5, 0xdb, 0xa2,
+	0x1a, 0x5f, 0x86, 0xd6, 0xf5, 0x20, 0x4e, 0x58, 0xc4, 0x44, 0xec, 0xd4, 0x15, 0x42, 0x9c, 0x23,
+	0x4c, 0xa7, 0x48, 0x6e, 0x84, 0xdf, 0x82, 0xce, 0x88, 0x45, 0x7e, 0x10, 0xed, 0x6f, 0xd3, 0x30,
+	0x8c, 0x9d, 0x86, 0x5a, 0xf4, 0x52, 0x21, 0xc0, 0xf9, 0x2c, 0x29, 0x99, 0x4a, 0x68, 0x7b, 0x91,
+	0xcf, 0x1e, 0x98, 0x96, 0x41, 0x0b, 0xd2, 0x85, 0x21, 0x0d, 0x43, 0xbd, 0x5b, 0x6b, 0xd1, 0x85,
+	0x74, 0x8a, 0xe4, 0x46, 0xb2, 0x65, 0xd9, 0xa6, 0x42, 0x2f, 0x00, 0xc5, 0x41, 0x26, 0xe3, 0x57,
+	0xa0, 0xb1, 0x1d, 0x06, 0x2a, 0x60, 0xed, 0x9e, 0xb5, 0x70, 0xab, 0xd3, 0xa9, 0x52, 0xd3, 0xd3,
+	0x59, 0xde, 0xf4, 0x74, 0x4b, 0x4d, 0x8f, 0xf7, 0x0e, 0x34, 0x53, 0x1e, 0xf2, 0xe0, 0xa1, 0x62,
+	0xf0, 0xce, 0x83, 0x35, 0x0a, 0x7c, 0x93, 0x30, 0x8a, 0x27, 0x4b, 0xb5, 0xf7, 0x31, 0xb4, 0x0b,
+	0x7c, 0x2c, 0xd9, 0xe2, 0xa8, 0x4e, 0x28, 0x7f, 0xa9, 0xd6, 0xb2, 0x97, 0xea, 0x7d, 0x04, 0xcd,
+	0x94, 0xa1, 0x25, 0x3b, 0x67, 0x37, 0xab, 0x5a, 0xbc, 0x59, 0x1e, 0x74, 0x0a, 0x55, 0x5a, 0x5f,
+	0xf3, 0x2e, 0x29, 0xe9, 0xbc, 0x1b, 0x00, 0x8a, 0x9e, 0xa3, 0x93, 0x53, 0x29, 0x80, 0xd5, 0x13,
+	0x04, 0xd0, 0xfb, 0x12, 0x01, 0x28, 0x52, 0x8f, 0xde, 0xf0, 0x1c, 0xd4, 0x09, 0xa3, 0xb1, 0xf9,
+	0x3a, 0x68, 0x11, 0x23, 0xc9, 0xa8, 0x11, 0x16, 0x27, 0x54, 0x24, 0xe9, 0xe7, 0x41, 0x26, 0x97,
+	0x9d, 0xa8, 0x9d, 0xc4, 0x89, 0x1f, 0x2c, 0x68, 0x7e, 0x48, 0xc3, 0xdd, 0x28, 0x11, 0x0f, 0x4f,
+	0xa7, 0xce, 0x62, 0x02, 0x6b, 0xcf, 0xb5, 0x5e, 0x26, 0xa4, 0x5e, 0xbe, 0x7a, 0x59, 0x77, 0x36,
+	0xac, 0x90, 0xe7, 0x97, 0x4b, 0x37, 0x74, 0x9b, 0xe3, 0xd4, 0x16, 0xdd, 0x28, 0xb5, 0x3f, 0xd2,
+	0x0d, 0xad, 0xc0, 0x03, 0xb0, 0x55, 0x89, 0x57, 0x49, 0xa1, 0x94, 0xd2, 0x8a, 0x95, 0x7f, 0x58,
+	0x21, 0xda, 0x0c, 0xbf, 0x26, 0x2b, 0x0a, 0x9b, 0xaa, 0xc4, 0x5b, 0x7a, 0xea, 0x85, 0x6a, 0x32,
+	0xac, 0x10, 0x65, 0x84, 0xdf, 0x86, 0x56, 0x96, 0x8d, 0xd5, 0x57, 0x43, 0x7b, 0x73, 0x23, 0x5f,
+	0xb1, 0x58, 0x84, 0x86, 0x15, 0x92, 0x9b, 0x1f, 0x9d, 0x20, 0xb6, 0x1a, 0x60, 0xab, 0x20, 0x79,
+	0x7f, 0x20, 0xe8, 0xbc, 0xc7, 0x67, 0x22, 0x4a, 0xa3, 0x96, 0xd9, 0xa3, 0x82, 0x7d, 0xf6, 0x41,
+	0x6d, 0x3e, 0xd7, 0xe5, 0xf8, 0x24, 0x2f, 0x48, 0x66, 0xeb, 0x72, 0xdd, 0x6a, 0x15, 0x4b, 0xd4,
+	0x85, 0xac, 0x47, 0x32, 0xb4, 0x15, 0xae, 0x57, 0x7a, 0x8d, 0x48, 0x6a, 0x92, 0xfd, 0x32, 0xa8,
+	0x17, 0x7e, 0x19, 0xbc, 0x0a, 0xb5, 0x2d, 0x1a, 0x33, 0xa7, 0xb1, 0xb8, 0x3c, 0xad, 0x17, 0x44,
+	0xcd, 0x6f, 0xbd, 0x71, 0xf8, 0xc4, 0xad, 0x3c, 0x7a, 0xe2, 0x56, 0x9e, 0x3d, 0x71, 0xd1, 0x17,
+	0x73, 0x17, 0x7d, 0x3f, 0x77, 0xd1, 0x2f, 0x73, 0x17, 0x1d, 0xce, 0x5d, 0xf4, 0xfb, 0xdc, 0x45,
+	0x7f, 0xcd, 0xdd, 0xca, 0xb3, 0xb9, 0x8b, 0xbe, 0x7e, 0xea, 0x56, 0x0e, 0x9f, 0xba, 0x95, 0x47,
+	0x4f, 0xdd, 0xca, 0x9d, 0xba, 0xfa, 0x9b, 0xf1, 0xfa, 0xdf, 0x03, 0x00, 0xb1, 0x9f, 0xa2, 0xfd,
+	0x21, 0x11, 0x00, 0x00,
 }
@@ -0,0 +1,15 @@
+package messages
+
+// Signed is a command carrying the principal that sent it
+type Signed interface {
+	GetPrincipal() *Principal
+	SetPrincipal(principal *Principal)
+}
+
+func (m *UpdateRequest) SetPrincipal(principal *Principal)            { m.Principal = principal }
+func (m *PickupRequest) SetPrincipal(principal *Principal)            { m.Principal = principal }
+func (m *DestinationPickupRequest) SetPrincipal(principal *Principal) { m.Principal = principal }
+func (m *CancelRequest) SetPrincipal(principal *Principal)            { m.Principal = principal }
+func (m *BatchRequest) SetPrincipal(principal *Principal)             { m.Principal = principal }
+func (m *StepRequest) SetPrincipal(principal *Principal)              { m.Principal = principal }
+func (m *MultiStepRequest) SetPrincipal(principal *Principal)         { m.Principal = principal }
@@ -0,0 +1,44 @@
+package messages
+
+import "fmt"
+
+// Version of the message semantics spoken by clients and elevators.
+// Bump it whenever a message changes meaning.
+// 2: elevators are named elevator-N instead of N
+const PROTOCOL_VERSION uint32 = 2
+
+// Amount of floors an elevator can address with its 16-bit goals
+const FLOORS uint32 = 16
+
+// Optional features an elevator may support
+const (
+	FEATURE_ARRIVAL     = "arrival"
+	FEATURE_DESTINATION = "destination"
+	FEATURE_BATCH       = "batch"
+	FEATURE_MULTISTEP   = "multistep"
+)
+
+// Features lists everything this build of the protocol implements
+var Features = []string{
+	FEATURE_ARRIVAL,
+	FEATURE_DESTINATION,
+	FEATURE_BATCH,
+	FEATURE_MULTISTEP,
+}
+
+// HasFeature reports whether feature is in features
+func HasFeature(features []string, feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ElevatorName is the actor name elevator id is spawned under, it stays
+// the same whichever process hosts the elevator
+func ElevatorName(id uint) string {
+	return fmt.Sprintf("elevator-%d", id)
+}
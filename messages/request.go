@@ -0,0 +1,18 @@
+package messages
+
+// Requested is a request the client waits on the answer of, the answer
+// carries the same Request
+type Requested interface {
+	GetRequest() uint64
+	SetRequest(request uint64)
+}
+
+func (m *HelloRequest) SetRequest(request uint64)             { m.Request = request }
+func (m *StatusRequest) SetRequest(request uint64)            { m.Request = request }
+func (m *UpdateRequest) SetRequest(request uint64)            { m.Request = request }
+func (m *PickupRequest) SetRequest(request uint64)            { m.Request = request }
+func (m *DestinationPickupRequest) SetRequest(request uint64) { m.Request = request }
+func (m *CancelRequest) SetRequest(request uint64)            { m.Request = request }
+func (m *BatchRequest) SetRequest(request uint64)             { m.Request = request }
+func (m *StepRequest) SetRequest(request uint64)              { m.Request = request }
+func (m *MultiStepRequest) SetRequest(request uint64)         { m.Request = request }
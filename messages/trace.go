@@ -0,0 +1,17 @@
+package messages
+
+// Traced is a request carrying the trace context of its sender
+type Traced interface {
+	GetTraceParent() string
+	SetTraceParent(traceparent string)
+}
+
+func (m *HelloRequest) SetTraceParent(traceparent string)             { m.TraceParent = traceparent }
+func (m *StatusRequest) SetTraceParent(traceparent string)            { m.TraceParent = traceparent }
+func (m *UpdateRequest) SetTraceParent(traceparent string)            { m.TraceParent = traceparent }
+func (m *PickupRequest) SetTraceParent(traceparent string)            { m.TraceParent = traceparent }
+func (m *DestinationPickupRequest) SetTraceParent(traceparent string) { m.TraceParent = traceparent }
+func (m *CancelRequest) SetTraceParent(traceparent string)            { m.TraceParent = traceparent }
+func (m *BatchRequest) SetTraceParent(traceparent string)             { m.TraceParent = traceparent }
+func (m *StepRequest) SetTraceParent(traceparent string)              { m.TraceParent = traceparent }
+func (m *MultiStepRequest) SetTraceParent(traceparent string)         { m.TraceParent = traceparent }
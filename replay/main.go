@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"dec/messages"
+	"dec/service/elevator"
+
+	"github.com/olekukonko/tablewriter"
+	log "github.com/sirupsen/logrus"
+)
+
+var flagJournal = flag.String("journal", "", "Journal written by the elevator service")
+var flagUntil = flag.Uint64("until", 0, "Stop after the entry with this index, replay everything when 0")
+var flagUntilTime = flag.String("until-time", "", "Stop before the first entry applied after this RFC 3339 time")
+var flagVerbose = flag.Bool("verbose", false, "Print the state after every entry instead of only the last one")
+
+func sender(entry *messages.JournalEntry) string {
+	if entry.Sender == nil {
+		return ""
+	}
+
+	return entry.Sender.Address + "/" + entry.Sender.Id
+}
+
+func row(entry *messages.JournalEntry, e *elevator.Elevator) []string {
+	command := elevator.CommandName(entry.Command)
+	// The state the car started from
+	if entry.Base != nil {
+		command = "base"
+	}
+
+	return []string{
+		strconv.FormatUint(entry.Index, 10),
+		entry.Time,
+		sender(entry),
+		entry.Principal,
+		entry.Role,
+		command,
+		strconv.Itoa(int(e.GetCurrentFloor())),
+		strconv.Itoa(e.FindNextGoal()),
+		strconv.Itoa(e.State),
+		fmt.Sprintf("%016b", e.BitVector),
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if *flagJournal == "" {
+		log.Fatal("--journal is required")
+	}
+
+	var until time.Time
+	if *flagUntilTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, *flagUntilTime)
+		if err != nil {
+			log.WithError(err).Fatal("invalid time")
+		}
+		until = t
+	}
+
+	f, err := os.Open(*flagJournal)
+	if err != nil {
+		log.WithError(err).Fatal("failed to open journal")
+	}
+	defer f.Close()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Index", "Time", "Sender", "Principal", "Role", "Command", "Floor", "Goal", "State", "Goals"})
+
+	// The car starts out idle on the ground floor like a fresh service,
+	// journals record the state it started from since
+	e := elevator.NewElevator(0)
+	var last []string
+	err = elevator.ReadJournal(f, func(entry *messages.JournalEntry) bool {
+		if *flagUntil != 0 && entry.Index > *flagUntil {
+			return false
+		}
+		if !until.IsZero() {
+			t, err := time.Parse(time.RFC3339Nano, entry.Time)
+			if err == nil && t.After(until) {
+				return false
+			}
+		}
+
+		e.ReplayJournal(entry)
+
+		last = row(entry, e)
+		if *flagVerbose {
+			table.Append(last)
+		}
+		return true
+	})
+	if err != nil {
+		log.WithError(err).Fatal("failed to read journal")
+	}
+
+	if last == nil {
+		log.Fatal("no entries to replay")
+	}
+	if !*flagVerbose {
+		table.Append(last)
+	}
+	table.Render()
+}
@@ -0,0 +1,148 @@
+package elevator
+
+import (
+	"time"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// How often a draining service checks whether the car calls are done
+const DRAIN_POLL = 100 * time.Millisecond
+
+// Messages from the process hosting the elevator, they never cross the
+// wire so they need no proto
+type drainRequest struct{}
+type idleRequest struct{}
+type stopRequest struct{}
+
+// Drain stops the car taking calls and drops the hall calls it has not
+// reached yet, the returned event hands them to the clients along with
+// the passengers waiting there. Car calls are kept so the passengers on
+// board still get out, also at floors that had a hall call.
+func (e *Elevator) Drain() *messages.DrainEvent {
+	e.Draining = true
+
+	event := &messages.DrainEvent{Id: uint32(e.Id)}
+	for floor := uint16(0); floor < uint16(messages.FLOORS); floor++ {
+		direction, ok := e.HallCalls[floor]
+		if !ok {
+			continue
+		}
+
+		call := &messages.HallCall{Floor: uint32(floor), State: int32(direction)}
+		for _, c := range e.PendingCalls[floor] {
+			call.Destinations = append(call.Destinations, uint32(c.Goal))
+		}
+		event.HallCalls = append(event.HallCalls, call)
+
+		e.dropHallCall(floor)
+	}
+
+	e.logger().WithField("hall_calls", len(event.HallCalls)).Info("draining, handing back hall calls")
+
+	return event
+}
+
+// dropHallCall takes back the hall call at floor and the passengers
+// waiting there, the car only keeps going there for a car call
+func (e *Elevator) dropHallCall(floor uint16) {
+	delete(e.HallCalls, floor)
+	delete(e.PendingCalls, floor)
+	if e.LockedPickupFloor == (1 << floor) {
+		e.LockedPickupFloor = 0
+		e.LockedDirection = 0
+	}
+	if e.CarCalls&(1<<floor) != 0 {
+		return
+	}
+
+	e.UnsetBit(floor)
+	delete(e.Listeners, floor)
+	if !e.HasGoals() {
+		e.State = IDLE
+	}
+}
+
+// persistNow writes a snapshot regardless of the length of the log
+func (e *Elevator) persistNow() {
+	if e.Store == nil {
+		return
+	}
+
+	if err := e.Store.Snapshot(e); err != nil {
+		e.logger().WithError(err).Error("snapshot failed")
+	}
+}
+
+func (e *Elevator) close() {
+	if e.Store != nil {
+		e.Store.Close()
+		e.Store = nil
+	}
+	if e.Journal != nil {
+		e.Journal.Close()
+		e.Journal = nil
+	}
+}
+
+// Shutdown drains the elevator at pid and, when finish is set, waits for
+// clients to step it through its car calls. It then persists the car.
+// Waiting is cut short so the whole shutdown takes at most timeout.
+func Shutdown(pid *actor.PID, finish bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	if _, err := pid.RequestFuture(&drainRequest{}, timeout).Result(); err != nil {
+		return err
+	}
+
+	for finish && time.Now().Add(DRAIN_POLL).Before(deadline) {
+		idle, err := pid.RequestFuture(&idleRequest{}, time.Until(deadline)).Result()
+		if err != nil {
+			return err
+		}
+		if idle.(bool) {
+			break
+		}
+		time.Sleep(DRAIN_POLL)
+	}
+
+	remaining := time.Until(deadline)
+	if remaining < DRAIN_POLL {
+		remaining = DRAIN_POLL
+	}
+	_, err := pid.RequestFuture(&stopRequest{}, remaining).Result()
+
+	return err
+}
+
+// Shutdown drains every elevator of the process at once
+func (services Services) Shutdown(finish bool, timeout time.Duration) error {
+	errs := make(chan error, len(services))
+	for _, s := range services {
+		go func(s *Service) {
+			errs <- Shutdown(s.PID, finish, timeout)
+		}(s)
+	}
+
+	var err error
+	for range services {
+		if e := <-errs; e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// Stop shuts the elevators down without waiting for car calls and stops
+// their actors, freeing their names for another group
+func (services Services) Stop(timeout time.Duration) error {
+	err := services.Shutdown(false, timeout)
+	for _, s := range services {
+		s.PID.Stop()
+	}
+
+	return err
+}
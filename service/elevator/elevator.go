@@ -1,14 +1,21 @@
 package elevator
 
 import (
-	"log"
+	"crypto/ed25519"
+	"fmt"
+	"math"
 	"math/bits"
+	"time"
 
+	"dec/internal/auth"
+	"dec/internal/logging"
 	"dec/messages"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/mailbox"
 	"github.com/AsynkronIT/protoactor-go/remote"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -17,64 +24,644 @@ const (
 	IDLE       = 0
 )
 
+// Upper bound of steps run for a single multi-step request
+const MAX_STEPS = 1 << 16
+
+type CarCall struct {
+	Goal   uint16
+	Sender *actor.PID
+}
+
 type Elevator struct {
 	Id                uint
 	BitVector         uint16
+	ServedFloors      uint16
 	Floor             uint16
 	State             int
 	LockedPickupFloor uint16
 	LockedDirection   int
+	Listeners         map[uint16][]*actor.PID
+	PendingCalls      map[uint16][]CarCall
+	HallCalls         map[uint16]int
+	Clients           map[string]*actor.PID
+	Draining          bool
+	Faulted           bool
+	Authority         ed25519.PublicKey
+	Policy            auth.Policy
+	Replays           *auth.Replays
+	Store             *Store
+	Journal           *Journal
+	LastCommand       *CommandRecord
+	Supervision       *Supervision
+	Meter             *Meter
+	Mailbox           *MailboxCounter
+	// Floors passengers on board asked for, part of BitVector
+	CarCalls uint16
+	// Replaying mutes notifications while the log is applied again
+	Replaying bool
+	// span of the message being handled
+	span trace.Span
+	// request being handled, echoed in the answers
+	request uint64
+	// stops made since the actor started, numbers the arrivals
+	stops uint64
 }
 
 func (e *Elevator) Receive(context actor.Context) {
+	e.span = e.startSpan(context.Message())
+	defer e.endSpan()
+	e.request = 0
+	if m, ok := context.Message().(messages.Requested); ok {
+		e.request = m.GetRequest()
+	}
+	e.commit(context.Message())
+	if sender := messageSender(context.Message()); sender != nil {
+		e.addClient(context, sender)
+	}
+
+	if e.shed(context.Message()) {
+		return
+	}
+
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		e.started(context)
+	case *actor.Restarting:
+		if e.Supervision != nil {
+			e.Supervision.handover(e)
+		}
+		// The state of a crashed actor is not kept
+		return
+	case *actor.Terminated:
+		delete(e.Clients, clientKey(msg.Who))
+	case *messages.HelloRequest:
+		if msg.Sender != nil {
+			e.addClient(context, msg.Sender)
+		}
+		res := e.Hello(msg.Version)
+		res.Request = msg.Request
+		e.reply(msg.Sender, res)
 	case *messages.StatusRequest:
-		msg.Sender.Tell(e.newStatusResponse())
+		e.reply(msg.Sender, e.newStatusResponse())
 	case *messages.UpdateRequest:
+		if !e.admit(msg) {
+			return
+		}
 		e.Update(int(msg.Goal), int(msg.State))
-		msg.Sender.Tell(e.newStatusResponse())
+		e.Listen(uint16(msg.Goal), msg.Sender)
+		e.reply(msg.Sender, e.newStatusResponse())
 	case *messages.PickupRequest:
+		if !e.admit(msg) {
+			return
+		}
 		e.Pickup(uint16(msg.Floor), int(msg.State))
-		msg.Sender.Tell(e.newStatusResponse())
+		e.Listen(uint16(msg.Floor), msg.Sender)
+		e.reply(msg.Sender, e.newStatusResponse())
+	case *messages.DestinationPickupRequest:
+		if !e.admit(msg) {
+			return
+		}
+		e.DestinationPickup(uint16(msg.Floor), uint16(msg.Destination), msg.Sender)
+		e.reply(msg.Sender, e.newStatusResponse())
+	case *messages.CancelRequest:
+		if !e.admit(msg) {
+			return
+		}
+		e.Cancel(uint16(msg.Floor))
+		e.reply(msg.Sender, e.newStatusResponse())
+	case *messages.BatchRequest:
+		if !e.admit(msg) {
+			return
+		}
+		e.Batch(msg.Commands, msg.Sender)
+		e.reply(msg.Sender, e.newStatusResponse())
 	case *messages.StepRequest:
+		if !e.admit(msg) {
+			return
+		}
 		e.Step()
-		msg.Sender.Tell(e.newStatusResponse())
+		e.reply(msg.Sender, e.newStatusResponse())
+	case *messages.MultiStepRequest:
+		if !e.admit(msg) {
+			return
+		}
+		steps, events := e.Steps(int(msg.Count), msg.UntilIdle)
+		e.reply(msg.Sender, &messages.MultiStepResponse{
+			Status: e.newStatusResponse(),
+			Steps:  uint32(steps),
+			Events: events,
+		})
+	case *drainRequest:
+		event := e.Drain()
+		for _, pid := range e.Clients {
+			pid.Tell(event)
+		}
+		e.persistNow()
+		context.Respond(event)
+	case *inspectRequest:
+		context.Respond(e.Inspect())
+	case *idleRequest:
+		context.Respond(!e.HasGoals())
+	case *stopRequest:
+		e.persistNow()
+		e.close()
+		context.Respond(true)
+	}
+
+	e.checkpoint()
+	if e.Supervision != nil {
+		e.Supervision.keep(e)
+	}
+	if e.Meter != nil {
+		e.Meter.observe(e.State)
+	}
+}
+
+// started runs when the actor comes up. After a crash the state it
+// resumed from is saved, which drops the command that crashed it from the
+// log so it is not replayed on the next start. The journal records the
+// state too, replays go on from it.
+func (e *Elevator) started(context actor.Context) {
+	// Clients restored from the snapshot
+	for _, pid := range e.Clients {
+		context.Watch(pid)
+	}
+
+	if e.Supervision != nil && e.Supervision.Restarts() > 0 {
+		if e.Faulted {
+			e.fault()
+		}
+		e.persistNow()
+	}
+
+	if e.Journal != nil {
+		if err := e.Journal.Base(e.Snapshot()); err != nil {
+			e.logger().WithError(err).Error("journal failed")
+		}
+	}
+}
+
+// addClient remembers pid to tell it when the car drains or faults. New
+// clients are saved right away so a restarted car still knows them, they
+// are forgotten once they terminate.
+func (e *Elevator) addClient(context actor.Context, pid *actor.PID) {
+	key := clientKey(pid)
+	if _, ok := e.Clients[key]; ok {
+		return
+	}
+
+	e.Clients[key] = pid
+	context.Watch(pid)
+	e.persistNow()
+}
+
+func clientKey(pid *actor.PID) string {
+	return pid.Address + "/" + pid.Id
+}
+
+// admit runs the checks a command goes through before it is applied and
+// reports whether it may run. The principal must be allowed its actions
+// and the car in service. Commands calling the car to floors are refused
+// while it drains or when it does not stop there. The command is logged
+// last, see persist.
+func (e *Elevator) admit(command auth.Command) bool {
+	var actions []string
+	var floors []uint32
+	var entry *messages.WalEntry
+	calls := true
+	switch msg := command.(type) {
+	case *messages.UpdateRequest:
+		actions, floors = []string{auth.ACTION_UPDATE}, []uint32{msg.Goal}
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_Update{Update: msg}}
+	case *messages.PickupRequest:
+		actions, floors = []string{auth.ACTION_PICKUP}, []uint32{msg.Floor}
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_Pickup{Pickup: msg}}
+	case *messages.DestinationPickupRequest:
+		actions, floors = []string{auth.ACTION_DESTINATION}, []uint32{msg.Floor, msg.Destination}
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_DestinationPickup{DestinationPickup: msg}}
+	case *messages.BatchRequest:
+		actions, floors = batchActions(msg.Commands), batchFloors(msg.Commands)
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_Batch{Batch: msg}}
+	case *messages.CancelRequest:
+		actions, calls = []string{auth.ACTION_CANCEL}, false
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_Cancel{Cancel: msg}}
+	case *messages.StepRequest:
+		actions, calls = []string{auth.ACTION_STEP}, false
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_Step{Step: msg}}
+	case *messages.MultiStepRequest:
+		actions, calls = []string{auth.ACTION_STEP}, false
+		entry = &messages.WalEntry{Entry: &messages.WalEntry_MultiStep{MultiStep: msg}}
+	default:
+		return true
+	}
+
+	sender := messageSender(command)
+	if e.deny(command, actions...) || e.faulty(sender) {
+		return false
+	}
+	if calls && (e.drained(sender) || e.unserved(sender, floors...)) {
+		return false
+	}
+
+	return e.persist(sender, entry)
+}
+
+// deny tells the sender of command why its principal may not run
+// actions, authorization is off when the elevator knows no authority
+func (e *Elevator) deny(command auth.Command, actions ...string) bool {
+	if e.Authority == nil {
+		return false
+	}
+
+	if err := e.Policy.Authorize(e.Authority, e.Replays, command, actions...); err != nil {
+		e.refuse(messageSender(command), err)
+		return true
+	}
+
+	return false
+}
+
+// persist logs entry ahead of applying it and reports whether the
+// command may run. The entry only counts once the command ran to the
+// end, see commit. The journal is only for debugging, a command is not
+// refused for failing to make it in there.
+func (e *Elevator) persist(sender *actor.PID, entry *messages.WalEntry) bool {
+	if e.Store != nil {
+		if err := e.Store.Append(entry); err != nil {
+			e.refuse(sender, fmt.Errorf("failed to persist command: %v", err))
+			return false
+		}
+	}
+
+	if e.Journal != nil {
+		if err := e.Journal.Record(entry); err != nil {
+			e.logger().WithError(err).Error("journal failed")
+		}
 	}
+	e.LastCommand = newCommandRecord(entry)
+
+	return true
+}
+
+// commit keeps the log entry of the previous message, any message after
+// it means the command ran to the end. The actor restarting after it
+// drops the entry instead so the command is not replayed.
+func (e *Elevator) commit(message interface{}) {
+	if e.Store == nil {
+		return
+	}
+
+	if _, ok := message.(*actor.Restarting); !ok {
+		e.Store.Commit()
+		return
+	}
+	if err := e.Store.Rollback(); err != nil {
+		e.logger().WithError(err).Error("rollback failed")
+	}
+}
+
+// checkpoint folds the log into a snapshot once it grew long enough
+func (e *Elevator) checkpoint() {
+	if e.Store == nil || !e.Store.Due() {
+		return
+	}
+
+	if err := e.Store.Snapshot(e); err != nil {
+		e.logger().WithError(err).Error("snapshot failed")
+	}
+}
+
+// drained refuses new calls once the car is draining
+func (e *Elevator) drained(sender *actor.PID) bool {
+	if !e.Draining {
+		return false
+	}
+	e.refuse(sender, fmt.Errorf("elevator %d is draining", e.Id))
+
+	return true
+}
+
+// unserved refuses calls to floors the car does not stop at
+func (e *Elevator) unserved(sender *actor.PID, floors ...uint32) bool {
+	for _, floor := range floors {
+		if floor >= messages.FLOORS || e.ServedFloors&(1<<floor) == 0 {
+			e.refuse(sender, fmt.Errorf("elevator %d does not serve floor %d", e.Id, floor))
+			return true
+		}
+	}
+
+	return false
+}
+
+// logger returns an entry about the elevator and the request it handles
+func (e *Elevator) logger() *log.Entry {
+	entry := logging.Elevator(e.Id)
+	if e.span != nil {
+		entry = logging.Span(e.span, entry)
+	}
+
+	return entry
+}
+
+// refuse tells sender why its command was not run
+func (e *Elevator) refuse(sender *actor.PID, err error) {
+	e.logger().WithError(err).Warn("command refused")
+	e.spanError(err)
+
+	res := e.newStatusResponse()
+	res.Error = err.Error()
+	e.reply(sender, res)
+}
+
+// reply tells sender res, commands may come without a sender to answer
+func (e *Elevator) reply(sender *actor.PID, res interface{}) {
+	if sender == nil {
+		return
+	}
+	sender.Tell(res)
+}
+
+// batchFloors are the floors the commands of a batch call the car to
+func batchFloors(commands []*messages.Command) []uint32 {
+	floors := []uint32{}
+	for _, command := range commands {
+		switch c := command.Command.(type) {
+		case *messages.Command_Update:
+			floors = append(floors, c.Update.Goal)
+		case *messages.Command_Pickup:
+			floors = append(floors, c.Pickup.Floor)
+		}
+	}
+
+	return floors
+}
+
+func batchActions(commands []*messages.Command) []string {
+	actions := []string{}
+	for _, command := range commands {
+		switch command.Command.(type) {
+		case *messages.Command_Update:
+			actions = append(actions, auth.ACTION_UPDATE)
+		case *messages.Command_Pickup:
+			actions = append(actions, auth.ACTION_PICKUP)
+		}
+	}
+
+	return actions
 }
 
 func NewElevator(id uint) *Elevator {
 	return &Elevator{
-		Id:        id,
-		BitVector: 0,
-		Floor:     (1 << 0),
-		State:     IDLE,
+		Id:           id,
+		BitVector:    0,
+		ServedFloors: math.MaxUint16,
+		Floor:        (1 << 0),
+		State:        IDLE,
+		Listeners:    make(map[uint16][]*actor.PID),
+		PendingCalls: make(map[uint16][]CarCall),
+		HallCalls:    make(map[uint16]int),
+		Clients:      make(map[string]*actor.PID),
+		Policy:       auth.DefaultPolicy,
 	}
 }
 
-func newElevatorActor(id uint) actor.Producer {
+// newElevatorActor starts from restored, the car as loaded from store,
+// and resumes the state of the crashed actor on restarts
+func newElevatorActor(restored *Elevator, servedFloors uint16, authority ed25519.PublicKey, store *Store, journal *Journal, supervision *Supervision, counter *MailboxCounter) actor.Producer {
+	id := restored.Id
+	// Outlive restarts like the supervision
+	meter := NewMeter(id)
+	replays := auth.NewReplays()
 	return func() actor.Actor {
-		return NewElevator(id)
+		e := restored
+		restored = nil
+		if e == nil {
+			e = NewElevator(id)
+			resumed := supervision != nil && supervision.resume(e)
+			if store != nil && !resumed {
+				// Taken out of service rather than taking down the
+				// other cars of the process
+				if err := store.Load(e); err != nil {
+					logging.Elevator(id).WithError(err).Error("failed to restore")
+					e.Faulted = true
+				}
+			}
+		}
+		e.ServedFloors = servedFloors
+		e.Authority = authority
+		e.Replays = replays
+		e.Supervision = supervision
+		e.Store = store
+		e.Journal = journal
+		e.Meter = meter
+		e.Mailbox = counter
+		meter.resume(e.State)
+		return e
 	}
 }
 
-func NewElevatorService(bind string, id uint) {
-	remote.Start(bind)
-	props := actor.FromProducer(newElevatorActor(id)).
-		WithMailbox(mailbox.Bounded(10000))
-	actor.SpawnNamed(props, string(id))
+// Service is an elevator actor hosted by this process
+type Service struct {
+	Id      uint
+	PID     *actor.PID
+	Mailbox *MailboxCounter
+	Started time.Time
+}
+
+// Persistence opens the store and journal of elevator id, either may be
+// nil to leave it out
+type Persistence func(id uint) (*Store, *Journal, error)
+
+// spawner spawns the actor of elevator id
+type spawner func(props *actor.Props, id uint) (*actor.PID, error)
+
+// named spawns an elevator under its stable name, remote clients find it
+// by that name
+func named(props *actor.Props, id uint) (*actor.PID, error) {
+	return actor.SpawnNamed(props, messages.ElevatorName(id))
+}
+
+// unique spawns an elevator under a name no other group of the process
+// uses
+func unique(props *actor.Props, id uint) (*actor.PID, error) {
+	return actor.SpawnPrefix(props, messages.ElevatorName(id)+"-")
+}
 
-	log.Println("elevator", id, "ready")
+// SpawnElevator restores elevator id from store, if any, and starts it in
+// this process under its stable name, shedding commands by overflow once
+// its mailbox fills up
+func SpawnElevator(id uint, servedFloors uint16, authority ed25519.PublicKey, store *Store, journal *Journal, overflow Overflow) (*Service, error) {
+	return spawnElevator(id, servedFloors, authority, store, journal, overflow, named)
+}
+
+func spawnElevator(id uint, servedFloors uint16, authority ed25519.PublicKey, store *Store, journal *Journal, overflow Overflow, spawn spawner) (*Service, error) {
+	restored := NewElevator(id)
+	if store != nil {
+		if err := store.Load(restored); err != nil {
+			return nil, fmt.Errorf("failed to restore elevator %d: %v", id, err)
+		}
+	}
+
+	counter := NewMailboxCounter(id, overflow)
+	supervision := NewSupervision(id)
+	props := actor.FromProducer(newElevatorActor(restored, servedFloors, authority, store, journal, supervision, counter)).
+		WithMailbox(mailbox.Bounded(MAILBOX_SIZE, counter)).
+		WithGuardian(supervision)
+	pid, err := spawn(props, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start elevator %d: %v", id, err)
+	}
+
+	logging.Elevator(id).Info("ready")
+
+	return &Service{
+		Id:      id,
+		PID:     pid,
+		Mailbox: counter,
+		Started: time.Now(),
+	}, nil
+}
+
+// SpawnElevators spawns the elevators of ids in this process, they are
+// only reachable from it until remoting is started. The elevators spawned
+// already are stopped again when one fails to start.
+func SpawnElevators(ids []uint, servedFloors uint16, authority ed25519.PublicKey, persistence Persistence, overflow Overflow) (Services, error) {
+	return spawnElevators(ids, servedFloors, authority, persistence, overflow, named)
+}
+
+// SpawnEmbedded spawns the elevators of ids under names unique to this
+// group, for groups running side by side in one process. Only the PIDs
+// of the services reach them.
+func SpawnEmbedded(ids []uint, persistence Persistence) (Services, error) {
+	return spawnElevators(ids, math.MaxUint16, nil, persistence, Overflow{}, unique)
+}
+
+func spawnElevators(ids []uint, servedFloors uint16, authority ed25519.PublicKey, persistence Persistence, overflow Overflow, spawn spawner) (Services, error) {
+	services := Services{}
+	for _, id := range ids {
+		s, err := openElevator(id, servedFloors, authority, persistence, overflow, spawn)
+		if err != nil {
+			services.Stop(PROBE_TIMEOUT)
+			return nil, err
+		}
+		services = append(services, s)
+	}
+
+	return services, nil
+}
+
+// openElevator opens the persistence of elevator id and spawns it
+func openElevator(id uint, servedFloors uint16, authority ed25519.PublicKey, persistence Persistence, overflow Overflow, spawn spawner) (*Service, error) {
+	var store *Store
+	var journal *Journal
+	if persistence != nil {
+		var err error
+		store, journal, err = persistence(id)
+		if err != nil {
+			return nil, fmt.Errorf("persistence setup of elevator %d failed: %v", id, err)
+		}
+	}
+
+	s, err := spawnElevator(id, servedFloors, authority, store, journal, overflow, spawn)
+	if err != nil {
+		if store != nil {
+			store.Close()
+		}
+		if journal != nil {
+			journal.Close()
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// NewElevatorService starts remoting on bind and spawns the elevators of
+// ids behind it. A process may host a single car for isolation or a whole
+// bank of them for large simulations.
+func NewElevatorService(bind string, ids []uint, servedFloors uint16, authority ed25519.PublicKey, persistence Persistence, overflow Overflow, options ...remote.RemotingOption) (Services, error) {
+	remote.Start(bind, options...)
+
+	return SpawnElevators(ids, servedFloors, authority, persistence, overflow)
+}
+
+// Hello answers a handshake, rejecting clients of another protocol version
+func (e *Elevator) Hello(version uint32) *messages.HelloResponse {
+	servedFloors := []uint32{}
+	for floor := uint32(0); floor < messages.FLOORS; floor++ {
+		if e.ServedFloors&(1<<floor) != 0 {
+			servedFloors = append(servedFloors, floor)
+		}
+	}
+
+	res := &messages.HelloResponse{
+		Id:           uint32(e.Id),
+		Version:      messages.PROTOCOL_VERSION,
+		Floors:       messages.FLOORS,
+		ServedFloors: servedFloors,
+		Features:     messages.Features,
+	}
+
+	if version != messages.PROTOCOL_VERSION {
+		res.Error = fmt.Sprintf("protocol version %d is not supported, expected %d",
+			version, messages.PROTOCOL_VERSION)
+		e.logger().WithField("reason", res.Error).Warn("rejected client")
+	}
+
+	return res
 }
 
 func (e *Elevator) newStatusResponse() *messages.StatusResponse {
 	return &messages.StatusResponse{
+		Id:       uint32(e.Id),
+		Floor:    uint32(e.GetCurrentFloor()),
+		Goal:     int32(e.FindNextGoal()),
+		State:    int32(e.State),
+		Draining: e.Draining,
+		Faulted:  e.Faulted,
+		Request:  e.request,
+	}
+}
+
+func (e *Elevator) newArrivalEvent() *messages.ArrivalEvent {
+	return &messages.ArrivalEvent{
 		Id:    uint32(e.Id),
 		Floor: uint32(e.GetCurrentFloor()),
-		Goal:  int32(e.FindNextGoal()),
 		State: int32(e.State),
+		Stop:  e.stops,
 	}
 }
 
+// Listen registers pid to be told once the car opens at floor
+func (e *Elevator) Listen(floor uint16, pid *actor.PID) {
+	if pid == nil {
+		return
+	}
+	// Already here, the doors open right away
+	if e.GetCurrentFloor() == floor {
+		e.notify(pid)
+		return
+	}
+	e.Listeners[floor] = append(e.Listeners[floor], pid)
+}
+
+func (e *Elevator) notify(pid *actor.PID) {
+	if e.Replaying {
+		return
+	}
+	pid.Tell(e.newArrivalEvent())
+}
+
+// Arrive notifies everyone waiting on the current floor
+func (e *Elevator) Arrive() {
+	floor := e.GetCurrentFloor()
+	for _, pid := range e.Listeners[floor] {
+		e.notify(pid)
+	}
+	delete(e.Listeners, floor)
+	delete(e.HallCalls, floor)
+}
+
 func (e *Elevator) Pickup(pickupFloor uint16, direction int) {
 	if e.State == IDLE {
 		e.State = e.GetPickupDirection(pickupFloor)
@@ -83,6 +670,67 @@ func (e *Elevator) Pickup(pickupFloor uint16, direction int) {
 	}
 	if e.GetCurrentFloor() != pickupFloor {
 		e.SetBit(pickupFloor)
+		e.HallCalls[pickupFloor] = direction
+	}
+}
+
+// DestinationPickup picks up at floor and registers the destination
+// as a car call once the car opens there
+func (e *Elevator) DestinationPickup(floor uint16, destination uint16, sender *actor.PID) {
+	direction := ASCENDING
+	if destination < floor {
+		direction = DESCENDING
+	}
+
+	e.Pickup(floor, direction)
+	e.Listen(floor, sender)
+	e.PendingCalls[floor] = append(e.PendingCalls[floor], CarCall{Goal: destination, Sender: sender})
+
+	if e.GetCurrentFloor() == floor {
+		e.Board()
+	}
+}
+
+// Board turns the destinations of passengers waiting on the current
+// floor into car calls
+func (e *Elevator) Board() {
+	floor := e.GetCurrentFloor()
+	for _, call := range e.PendingCalls[floor] {
+		e.Update(int(call.Goal), e.GetPickupDirection(call.Goal))
+		e.Listen(call.Goal, call.Sender)
+	}
+	delete(e.PendingCalls, floor)
+}
+
+// Cancel drops every call for floor and releases a pickup lock on it
+func (e *Elevator) Cancel(floor uint16) {
+	e.UnsetBit(floor)
+	delete(e.Listeners, floor)
+	delete(e.PendingCalls, floor)
+	delete(e.HallCalls, floor)
+
+	if e.LockedPickupFloor == (1 << floor) {
+		e.LockedPickupFloor = 0
+		e.LockedDirection = 0
+	}
+
+	// Nothing left to do
+	if !e.HasGoals() {
+		e.State = IDLE
+	}
+}
+
+// Batch applies the commands in order on behalf of sender
+func (e *Elevator) Batch(commands []*messages.Command, sender *actor.PID) {
+	for _, command := range commands {
+		switch c := command.Command.(type) {
+		case *messages.Command_Update:
+			e.Update(int(c.Update.Goal), int(c.Update.State))
+			e.Listen(uint16(c.Update.Goal), sender)
+		case *messages.Command_Pickup:
+			e.Pickup(uint16(c.Pickup.Floor), int(c.Pickup.State))
+			e.Listen(uint16(c.Pickup.Floor), sender)
+		}
 	}
 }
 
@@ -127,8 +775,10 @@ func (e *Elevator) SetBit(n uint16) {
 	e.BitVector |= (1 << n)
 }
 
+// UnsetBit drops the goal at floor n along with its car call
 func (e *Elevator) UnsetBit(n uint16) {
 	e.BitVector &= ^(1 << n)
+	e.CarCalls &= ^(1 << n)
 }
 
 func (e *Elevator) Move() {
@@ -168,10 +818,15 @@ func (e *Elevator) Step() {
 		return
 	}
 
+	goals := e.BitVector
+
 	// Move towards the next goal
 	if e.HasGoals() {
 		if e.FindNextGoal() != -1 {
 			e.Move()
+			if e.Meter != nil && !e.Replaying {
+				e.Meter.moved()
+			}
 		} else if e.FindNextGoal() == -1 {
 			// Toggle state if we have goals but not current direction
 			e.ToggleState()
@@ -189,6 +844,50 @@ func (e *Elevator) Step() {
 		e.LockedPickupFloor = 0
 		e.LockedDirection = 0
 	}
+
+	if goals&e.Floor != 0 && !e.HasGoalAtCurrentFloor() {
+		e.stops++
+		if e.Meter != nil && !e.Replaying {
+			e.Meter.stopped()
+		}
+	}
+
+	// Let requesters know the doors opened
+	if !e.HasGoalAtCurrentFloor() {
+		e.Arrive()
+		e.Board()
+	}
+}
+
+// Steps runs count steps, or until idle when untilIdle is set, and
+// returns the steps taken along with the arrivals on the way. A count of
+// 0 runs no step unless untilIdle is set, it then bounds nothing.
+func (e *Elevator) Steps(count int, untilIdle bool) (int, []*messages.ArrivalEvent) {
+	var events []*messages.ArrivalEvent
+
+	if count <= 0 && !untilIdle {
+		return 0, events
+	}
+	if count <= 0 || count > MAX_STEPS {
+		count = MAX_STEPS
+	}
+
+	steps := 0
+	for ; steps < count; steps++ {
+		if untilIdle && e.State == IDLE {
+			break
+		}
+
+		goals := e.BitVector
+		e.Step()
+
+		// A goal was cleared on the floor we are at now
+		if goals&e.Floor != 0 && !e.HasGoalAtCurrentFloor() {
+			events = append(events, e.newArrivalEvent())
+		}
+	}
+
+	return steps, events
 }
 
 func (e *Elevator) ToggleState() {
@@ -212,6 +911,7 @@ func (e *Elevator) Update(goal int, state int) {
 		e.State = state
 	}
 	e.SetBit(uint16(goal))
+	e.CarCalls |= 1 << uint16(goal)
 }
 
 func (e *Elevator) Status() []int {
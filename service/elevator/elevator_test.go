@@ -1,8 +1,16 @@
 package elevator
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	_ "fmt"
 	"testing"
+	"time"
+
+	"dec/internal/auth"
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
 )
 
 func TestPickup(t *testing.T) {
@@ -120,6 +128,249 @@ func TestStep(t *testing.T) {
 	}
 }
 
+func TestArrive(t *testing.T) {
+	e := NewElevator(0)
+	pid := actor.NewLocalPID("passenger")
+	// already on the lobby, nobody has to wait
+	e.Listen(0, pid)
+	if len(e.Listeners) != 0 {
+		t.Error("Expected 0, got ", len(e.Listeners))
+	}
+	e.Pickup(2, ASCENDING)
+	e.Listen(2, pid)
+	if len(e.Listeners[2]) != 1 {
+		t.Error("Expected 1, got ", len(e.Listeners[2]))
+	}
+	e.Step()
+	if len(e.Listeners[2]) != 1 {
+		t.Error("Expected 1, got ", len(e.Listeners[2]))
+	}
+	// doors open on floor 2 and the listener is released
+	e.Step()
+	if len(e.Listeners) != 0 {
+		t.Error("Expected 0, got ", len(e.Listeners))
+	}
+}
+
+func TestDestinationPickup(t *testing.T) {
+	e := NewElevator(0)
+	// a passenger on floor 2 asks for floor 4 at the kiosk
+	e.DestinationPickup(2, 4, nil)
+	status := e.Status()
+	if status[0] != 0 ||
+		status[1] != 2 ||
+		status[2] != 1 {
+		t.Error("Expected {0, 2, 1}, got ", status)
+	}
+	e.Step()
+	e.Step()
+	// the car opens on floor 2 and the destination becomes a car call
+	status = e.Status()
+	if status[0] != 2 ||
+		status[1] != 4 ||
+		status[2] != 1 {
+		t.Error("Expected {2, 4, 1}, got ", status)
+	}
+	if len(e.PendingCalls) != 0 {
+		t.Error("Expected 0, got ", len(e.PendingCalls))
+	}
+	e.Step()
+	e.Step()
+	status = e.Status()
+	if status[0] != 4 ||
+		status[1] != -1 ||
+		status[2] != 0 {
+		t.Error("Expected {4, -1, 0}, got ", status)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	e := NewElevator(0)
+	e.Batch([]*messages.Command{
+		{Command: &messages.Command_Update{Update: &messages.UpdateRequest{Goal: 3, State: ASCENDING}}},
+		{Command: &messages.Command_Update{Update: &messages.UpdateRequest{Goal: 5, State: ASCENDING}}},
+		{Command: &messages.Command_Pickup{Pickup: &messages.PickupRequest{Floor: 7, State: DESCENDING}}},
+	}, nil)
+	// 0000 0000 1010 1000
+	if e.BitVector != 168 {
+		t.Error("Expected 168, got ", e.BitVector)
+	}
+	status := e.Status()
+	if status[0] != 0 ||
+		status[1] != 3 ||
+		status[2] != 1 {
+		t.Error("Expected {0, 3, 1}, got ", status)
+	}
+}
+
+func TestSteps(t *testing.T) {
+	e := NewElevator(0)
+	e.Update(2, ASCENDING)
+	e.Update(4, ASCENDING)
+
+	// run until the car has nothing left to do
+	steps, events := e.Steps(0, true)
+	if steps != 4 {
+		t.Error("Expected 4, got ", steps)
+	}
+	if len(events) != 2 ||
+		events[0].Floor != 2 ||
+		events[1].Floor != 4 {
+		t.Error("Expected stops at {2, 4}, got ", events)
+	}
+	if events[0].Stop == events[1].Stop {
+		t.Error("Expected every stop to be numbered, got ", events)
+	}
+	if e.State != IDLE {
+		t.Error("Expected 0, got ", e.State)
+	}
+
+	// a bounded run keeps stepping while idle
+	steps, events = e.Steps(3, false)
+	if steps != 3 || len(events) != 0 {
+		t.Error("Expected 3 steps and no stops, got ", steps, events)
+	}
+
+	// no count is no run
+	if steps, _ = e.Steps(0, false); steps != 0 {
+		t.Error("Expected 0, got ", steps)
+	}
+
+	// a second stop at the same floor is not the first one again
+	e.Update(2, DESCENDING)
+	_, first := e.Steps(0, true)
+	e.Update(4, ASCENDING)
+	e.Steps(0, true)
+	e.Update(2, DESCENDING)
+	_, second := e.Steps(0, true)
+	if len(first) != 1 || len(second) != 1 || first[0].Stop == second[0].Stop {
+		t.Error("Expected two stops at 2 with their own numbers, got ", first, second)
+	}
+}
+
+func TestHello(t *testing.T) {
+	e := NewElevator(0)
+	e.ServedFloors = 0x000F
+
+	res := e.Hello(messages.PROTOCOL_VERSION)
+	if res.Error != "" {
+		t.Error("Expected no error, got ", res.Error)
+	}
+	if len(res.ServedFloors) != 4 || res.ServedFloors[3] != 3 {
+		t.Error("Expected {0, 1, 2, 3}, got ", res.ServedFloors)
+	}
+
+	// clients of another version are turned away
+	res = e.Hello(messages.PROTOCOL_VERSION + 1)
+	if res.Error == "" {
+		t.Error("Expected an error, got none")
+	}
+}
+
+func TestCancel(t *testing.T) {
+	e := NewElevator(0)
+	e.Pickup(3, DESCENDING)
+	e.Update(5, ASCENDING)
+
+	// the pickup is withdrawn, the car call remains
+	e.Cancel(3)
+	if e.IsLocked() {
+		t.Error("Expected false, got ", e.IsLocked())
+	}
+	status := e.Status()
+	if status[0] != 0 ||
+		status[1] != 5 ||
+		status[2] != 1 {
+		t.Error("Expected {0, 5, 1}, got ", status)
+	}
+
+	// without goals the car goes idle
+	e.Cancel(5)
+	status = e.Status()
+	if status[0] != 0 ||
+		status[1] != -1 ||
+		status[2] != 0 {
+		t.Error("Expected {0, -1, 0}, got ", status)
+	}
+}
+
+func TestDeny(t *testing.T) {
+	e := NewElevator(0)
+	sender := actor.NewLocalPID("client")
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// authorization is off without an authority
+	if e.deny(&messages.UpdateRequest{Sender: sender}, auth.ACTION_UPDATE) {
+		t.Error("Expected false, got true")
+	}
+
+	e.Authority = public
+	e.Replays = auth.NewReplays()
+	if !e.deny(&messages.PickupRequest{Sender: sender}, auth.ACTION_PICKUP) {
+		t.Error("Expected anonymous requests to be denied")
+	}
+	passenger, err := auth.NewCredentials(private, "bob", auth.PASSENGER, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pickup := &messages.PickupRequest{Sender: sender, Floor: 3}
+	passenger.Sign(pickup)
+	if e.deny(pickup, auth.ACTION_PICKUP) {
+		t.Error("Expected passengers to pick up")
+	}
+	// the same request is only taken once
+	if !e.deny(pickup, auth.ACTION_PICKUP) {
+		t.Error("Expected a replayed request to be denied")
+	}
+	// one car call in a batch denies all of it
+	batch := &messages.BatchRequest{Sender: sender}
+	passenger.Sign(batch)
+	if !e.deny(batch, auth.ACTION_PICKUP, auth.ACTION_UPDATE) {
+		t.Error("Expected passengers to be denied car calls")
+	}
+}
+
+func TestUnserved(t *testing.T) {
+	e := NewElevator(0)
+	e.ServedFloors = 0x000F
+	sender := actor.NewLocalPID("client")
+
+	if e.unserved(sender, 0, 3) {
+		t.Error("Expected floors 0 and 3 to be served")
+	}
+	if !e.unserved(sender, 2, 4) {
+		t.Error("Expected floor 4 to be refused")
+	}
+	if !e.unserved(sender, messages.FLOORS) {
+		t.Error("Expected a floor out of range to be refused")
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	e := NewElevator(0)
+	e.ServedFloors = 0x000F
+
+	// commands without a sender are refused without an answer
+	if e.admit(&messages.PickupRequest{Floor: 4}) {
+		t.Error("Expected floor 4 to be refused")
+	}
+	if !e.admit(&messages.UpdateRequest{Goal: 3}) {
+		t.Error("Expected floor 3 to be admitted")
+	}
+
+	// draining cars still step but take no calls
+	e.Draining = true
+	if e.admit(&messages.UpdateRequest{Goal: 3}) {
+		t.Error("Expected a draining car to refuse calls")
+	}
+	if !e.admit(&messages.StepRequest{}) {
+		t.Error("Expected a draining car to step")
+	}
+}
+
 func TestLSB(t *testing.T) {
 	e := NewElevator(0)
 
@@ -296,3 +547,60 @@ func TestUnsetBit(t *testing.T) {
 		t.Error("Expected 0, got ", e.BitVector)
 	}
 }
+
+func TestDrain(t *testing.T) {
+	e := NewElevator(0)
+	e.Pickup(3, DESCENDING)
+	e.DestinationPickup(7, 2, nil)
+	e.Update(5, ASCENDING)
+
+	// the hall calls are handed back, the car call remains
+	event := e.Drain()
+	if !e.Draining {
+		t.Error("Expected true, got ", e.Draining)
+	}
+	if len(event.HallCalls) != 2 {
+		t.Fatal("Expected 2 hall calls, got ", len(event.HallCalls))
+	}
+	if event.HallCalls[0].Floor != 3 || event.HallCalls[0].State != DESCENDING {
+		t.Error("Expected pickup at floor 3 going down, got ", event.HallCalls[0])
+	}
+	if event.HallCalls[1].Floor != 7 || len(event.HallCalls[1].Destinations) != 1 ||
+		event.HallCalls[1].Destinations[0] != 2 {
+		t.Error("Expected pickup at floor 7 going to 2, got ", event.HallCalls[1])
+	}
+	if e.BitVector != (1 << 5) {
+		t.Error("Expected only the car call to floor 5, got ", e.BitVector)
+	}
+	if !e.newStatusResponse().Draining {
+		t.Error("Expected the status to report draining")
+	}
+}
+
+func TestDrainKeepsCarCalls(t *testing.T) {
+	e := NewElevator(0)
+	e.Update(5, ASCENDING)
+	listener := actor.NewLocalPID("passenger")
+	e.Listen(5, listener)
+	// someone waits where a passenger on board gets out
+	e.Pickup(5, DESCENDING)
+
+	event := e.Drain()
+	if len(event.HallCalls) != 1 || event.HallCalls[0].Floor != 5 {
+		t.Fatal("Expected the pickup at floor 5 to be handed back, got ", event.HallCalls)
+	}
+	if _, ok := e.HallCalls[5]; ok {
+		t.Error("Expected the hall call to be dropped")
+	}
+	if e.BitVector != (1<<5) || len(e.Listeners[5]) != 1 {
+		t.Error("Expected the car to still stop at floor 5, got ", e.BitVector)
+	}
+
+	// arriving there ends the car call
+	for e.GetCurrentFloor() != 5 {
+		e.Move()
+	}
+	if e.CarCalls != 0 {
+		t.Error("Expected no car calls left, got ", e.CarCalls)
+	}
+}
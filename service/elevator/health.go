@@ -0,0 +1,259 @@
+package elevator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"dec/messages"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+// Time the elevator has to answer a probe before it is considered hung
+const PROBE_TIMEOUT = time.Second
+
+// Messages from the probes, local only like the drain messages
+type inspectRequest struct{}
+
+// CommandRecord describes a command applied by the elevator
+type CommandRecord struct {
+	Command   string    `json:"command"`
+	Time      time.Time `json:"time"`
+	Sender    string    `json:"sender,omitempty"`
+	Principal string    `json:"principal,omitempty"`
+}
+
+func newCommandRecord(entry *messages.WalEntry) *CommandRecord {
+	record := &CommandRecord{
+		Command: CommandName(entry),
+		Time:    time.Now().UTC(),
+	}
+	sender, principal := commandSender(entry)
+	if sender != nil {
+		record.Sender = sender.Address + "/" + sender.Id
+	}
+	if principal != nil {
+		record.Principal = principal.Name
+	}
+
+	return record
+}
+
+// Inspection is the full state of an elevator as served by /debug/elevator
+type Inspection struct {
+	Id                uint                `json:"id"`
+	Floor             uint16              `json:"floor"`
+	Goal              int                 `json:"goal"`
+	State             int                 `json:"state"`
+	BitVector         string              `json:"bitVector"`
+	ServedFloors      string              `json:"servedFloors"`
+	LockedPickupFloor int                 `json:"lockedPickupFloor"`
+	LockedDirection   int                 `json:"lockedDirection"`
+	Draining          bool                `json:"draining"`
+	Faulted           bool                `json:"faulted"`
+	Restarts          int                 `json:"restarts"`
+	Listeners         map[uint16][]string `json:"listeners"`
+	PendingCalls      map[uint16][]uint16 `json:"pendingCalls"`
+	HallCalls         map[uint16]int      `json:"hallCalls"`
+	Clients           []string            `json:"clients"`
+	Persistent        bool                `json:"persistent"`
+	LastCommand       *CommandRecord      `json:"lastCommand"`
+	MailboxDepth      int64               `json:"mailboxDepth"`
+	Uptime            string              `json:"uptime"`
+}
+
+// Inspect captures the state of the car, the caller adds what only the
+// hosting process knows
+func (e *Elevator) Inspect() *Inspection {
+	i := &Inspection{
+		Id:                e.Id,
+		Floor:             e.GetCurrentFloor(),
+		Goal:              e.FindNextGoal(),
+		State:             e.State,
+		BitVector:         fmt.Sprintf("%016b", e.BitVector),
+		ServedFloors:      fmt.Sprintf("%016b", e.ServedFloors),
+		LockedPickupFloor: -1,
+		LockedDirection:   e.LockedDirection,
+		Draining:          e.Draining,
+		Faulted:           e.Faulted,
+		Listeners:         map[uint16][]string{},
+		PendingCalls:      map[uint16][]uint16{},
+		HallCalls:         map[uint16]int{},
+		Clients:           []string{},
+		Persistent:        e.Store != nil,
+		LastCommand:       e.LastCommand,
+	}
+	if e.Supervision != nil {
+		i.Restarts = e.Supervision.Restarts()
+	}
+	if e.IsLocked() {
+		i.LockedPickupFloor = LSB16(e.LockedPickupFloor)
+	}
+	for floor, pids := range e.Listeners {
+		for _, pid := range pids {
+			i.Listeners[floor] = append(i.Listeners[floor], pid.Address+"/"+pid.Id)
+		}
+	}
+	for floor, calls := range e.PendingCalls {
+		for _, call := range calls {
+			i.PendingCalls[floor] = append(i.PendingCalls[floor], call.Goal)
+		}
+	}
+	for floor, direction := range e.HallCalls {
+		i.HallCalls[floor] = direction
+	}
+	for client := range e.Clients {
+		i.Clients = append(i.Clients, client)
+	}
+
+	return i
+}
+
+// inspect asks the actor for its state, failing when it does not answer
+func (s *Service) inspect() (*Inspection, error) {
+	res, err := s.PID.RequestFuture(&inspectRequest{}, PROBE_TIMEOUT).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	i := res.(*Inspection)
+	i.MailboxDepth = s.Mailbox.Depth()
+	i.Uptime = time.Since(s.Started).String()
+
+	return i, nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// Services are the elevators hosted by this process
+type Services []*Service
+
+func (services Services) find(id uint) *Service {
+	for _, s := range services {
+		if s.Id == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// healthz is alive as long as every actor answers and no car was taken
+// out of service, restarting the process restores a faulted car
+func (services Services) healthz(w http.ResponseWriter, r *http.Request) {
+	for _, s := range services {
+		i, err := s.inspect()
+		switch {
+		case err != nil:
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: %v", s.Id, err),
+			})
+			return
+		case i.Faulted:
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: faulted after %d restarts", s.Id, i.Restarts),
+			})
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readyz takes calls unless a car is draining or faulted
+func (services Services) readyz(w http.ResponseWriter, r *http.Request) {
+	for _, s := range services {
+		i, err := s.inspect()
+		switch {
+		case err != nil:
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: %v", s.Id, err),
+			})
+			return
+		case i.Draining:
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: draining", s.Id),
+			})
+			return
+		case i.Faulted:
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: faulted", s.Id),
+			})
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+}
+
+// introspect serves one elevator, picked by the id parameter when the
+// process hosts several
+func (services Services) introspect(w http.ResponseWriter, r *http.Request) {
+	var s *Service
+	if v := r.URL.Query().Get("id"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err == nil {
+			s = services.find(uint(id))
+		}
+		if s == nil {
+			writeJSON(w, http.StatusNotFound, map[string]string{"status": "unknown elevator " + strconv.Quote(v)})
+			return
+		}
+	} else if len(services) == 1 {
+		s = services[0]
+	} else {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"status": "id is required, this process hosts several elevators"})
+		return
+	}
+
+	i, err := s.inspect()
+	if err != nil {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": err.Error()})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, i)
+}
+
+// introspectAll serves every elevator of the process
+func (services Services) introspectAll(w http.ResponseWriter, r *http.Request) {
+	inspections := []*Inspection{}
+	for _, s := range services {
+		i, err := s.inspect()
+		if err != nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{
+				"status": fmt.Sprintf("elevator %d: %v", s.Id, err),
+			})
+			return
+		}
+		inspections = append(inspections, i)
+	}
+
+	writeJSON(w, http.StatusOK, inspections)
+}
+
+// Handler serves the probes, the introspection endpoints and the metrics
+func (services Services) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", services.healthz)
+	mux.HandleFunc("/readyz", services.readyz)
+	mux.HandleFunc("/debug/elevator", services.introspect)
+	mux.HandleFunc("/debug/elevators", services.introspectAll)
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+// ServeHealth serves the probes on bind until the listener fails
+func (services Services) ServeHealth(bind string) {
+	log.WithField("address", bind).Info("serving health")
+	log.WithError(http.ListenAndServe(bind, services.Handler())).Error("health endpoint stopped")
+}
@@ -0,0 +1,75 @@
+package elevator
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+)
+
+func TestHealth(t *testing.T) {
+	counter := &MailboxCounter{}
+	// commands still waiting behind the probes
+	counter.MessagePosted(&messages.StepRequest{})
+	counter.MessagePosted(&messages.StepRequest{})
+	props := actor.FromProducer(newElevatorActor(NewElevator(0), math.MaxUint16, nil, nil, nil, nil, counter)).
+		WithMailbox(mailbox.Bounded(MAILBOX_SIZE, counter))
+	pid := actor.Spawn(props)
+	defer pid.Stop()
+
+	s := &Service{Id: 0, PID: pid, Mailbox: counter, Started: time.Now()}
+	handler := Services{s}.Handler()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	if w := get("/healthz"); w.Code != http.StatusOK {
+		t.Error("Expected 200, got ", w.Code)
+	}
+	if w := get("/readyz"); w.Code != http.StatusOK {
+		t.Error("Expected 200, got ", w.Code)
+	}
+
+	w := get("/debug/elevator")
+	inspection := &Inspection{}
+	if err := json.NewDecoder(w.Body).Decode(inspection); err != nil {
+		t.Fatal(err)
+	}
+	if inspection.Floor != 0 || inspection.State != IDLE || inspection.Uptime == "" {
+		t.Error("Expected an idle car on floor 0, got ", inspection)
+	}
+	// the probe itself may not be counted out yet
+	if inspection.MailboxDepth < 2 || inspection.MailboxDepth > 3 {
+		t.Error("Expected a depth of 2 or 3, got ", inspection.MailboxDepth)
+	}
+
+	// a draining car is alive but not ready
+	if _, err := pid.RequestFuture(&drainRequest{}, PROBE_TIMEOUT).Result(); err != nil {
+		t.Fatal(err)
+	}
+	if w := get("/healthz"); w.Code != http.StatusOK {
+		t.Error("Expected 200, got ", w.Code)
+	}
+	if w := get("/readyz"); w.Code != http.StatusServiceUnavailable {
+		t.Error("Expected 503, got ", w.Code)
+	}
+
+	// the messages handled are counted out again
+	deadline := time.Now().Add(PROBE_TIMEOUT)
+	for counter.Depth() != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if counter.Depth() != 2 {
+		t.Error("Expected 2, got ", counter.Depth())
+	}
+}
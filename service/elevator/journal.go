@@ -0,0 +1,177 @@
+package elevator
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Journal is an append-only record of every command an elevator applied,
+// one JSON object per line. Unlike the write-ahead log of the Store it is
+// never truncated, replaying it from the start rebuilds the car at any
+// point of its history. Every start of the car records the state it
+// started from, which covers restored snapshots and restarts. Principals
+// are recorded by name and role, their keys and signatures are left out.
+type Journal struct {
+	File  *os.File
+	index uint64
+}
+
+var journalMarshaler = jsonpb.Marshaler{}
+
+// OpenJournal appends to the journal at path, numbering on from the
+// entries already in it. A line cut short by a crash is dropped.
+func OpenJournal(path string) (*Journal, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Journal{File: f}
+	var valid int64
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			break
+		}
+		entry := &messages.JournalEntry{}
+		if err := jsonpb.UnmarshalString(line, entry); err != nil {
+			break
+		}
+		valid += int64(len(line))
+		j.index = entry.Index
+	}
+
+	if err := f.Truncate(valid); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// Record appends command along with who sent it and when
+func (j *Journal) Record(command *messages.WalEntry) error {
+	command = proto.Clone(command).(*messages.WalEntry)
+	sender, principal := commandSender(command)
+
+	entry := &messages.JournalEntry{
+		Sender:  sender,
+		Command: command,
+	}
+	if principal != nil {
+		entry.Principal = principal.Name
+		entry.Role = principal.Role
+		*principal = messages.Principal{Name: principal.Name, Role: principal.Role}
+	}
+
+	return j.append(entry)
+}
+
+// Base appends the state the car starts from
+func (j *Journal) Base(snapshot *messages.Snapshot) error {
+	return j.append(&messages.JournalEntry{Base: snapshot})
+}
+
+func (j *Journal) append(entry *messages.JournalEntry) error {
+	entry.Index = j.index + 1
+	entry.Time = time.Now().UTC().Format(time.RFC3339Nano)
+
+	line, err := journalMarshaler.MarshalToString(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := j.File.WriteString(line + "\n"); err != nil {
+		return err
+	}
+	j.index = entry.Index
+
+	return nil
+}
+
+func (j *Journal) Close() error {
+	return j.File.Close()
+}
+
+func commandSender(command *messages.WalEntry) (*actor.PID, *messages.Principal) {
+	switch c := command.Entry.(type) {
+	case *messages.WalEntry_Update:
+		return c.Update.Sender, c.Update.Principal
+	case *messages.WalEntry_Pickup:
+		return c.Pickup.Sender, c.Pickup.Principal
+	case *messages.WalEntry_DestinationPickup:
+		return c.DestinationPickup.Sender, c.DestinationPickup.Principal
+	case *messages.WalEntry_Cancel:
+		return c.Cancel.Sender, c.Cancel.Principal
+	case *messages.WalEntry_Batch:
+		return c.Batch.Sender, c.Batch.Principal
+	case *messages.WalEntry_Step:
+		return c.Step.Sender, c.Step.Principal
+	case *messages.WalEntry_MultiStep:
+		return c.MultiStep.Sender, c.MultiStep.Principal
+	}
+
+	return nil, nil
+}
+
+// CommandName names the command of a journal entry
+func CommandName(command *messages.WalEntry) string {
+	switch command.GetEntry().(type) {
+	case *messages.WalEntry_Update:
+		return "update"
+	case *messages.WalEntry_Pickup:
+		return "pickup"
+	case *messages.WalEntry_DestinationPickup:
+		return "destination"
+	case *messages.WalEntry_Cancel:
+		return "cancel"
+	case *messages.WalEntry_Batch:
+		return "batch"
+	case *messages.WalEntry_Step:
+		return "step"
+	case *messages.WalEntry_MultiStep:
+		return "multistep"
+	}
+
+	return "unknown"
+}
+
+// ReplayJournal applies entry to e, a base entry puts e into the state
+// it holds
+func (e *Elevator) ReplayJournal(entry *messages.JournalEntry) {
+	if entry.Base != nil {
+		e.Restore(entry.Base)
+		return
+	}
+
+	e.Replay(entry.Command)
+}
+
+// ReadJournal hands the entries read from r to fn in order until fn
+// returns false. A line cut short by a crash ends the journal.
+func ReadJournal(r io.Reader, fn func(*messages.JournalEntry) bool) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// EOF or a torn last line
+			return nil
+		}
+
+		entry := &messages.JournalEntry{}
+		if err := jsonpb.UnmarshalString(line, entry); err != nil {
+			return err
+		}
+		if !fn(entry) {
+			return nil
+		}
+	}
+}
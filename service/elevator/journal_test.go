@@ -0,0 +1,114 @@
+package elevator
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "journal")
+
+	j, err := OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := actor.NewPID("127.0.0.1:8999", "client")
+	j.Record(&messages.WalEntry{Entry: &messages.WalEntry_Update{Update: &messages.UpdateRequest{
+		Sender:    sender,
+		Goal:      4,
+		State:     ASCENDING,
+		Principal: &messages.Principal{Name: "alice", Role: "operator", Signature: []byte("secret")},
+	}}})
+	j.Record(&messages.WalEntry{Entry: &messages.WalEntry_MultiStep{MultiStep: &messages.MultiStepRequest{Count: 2}}})
+	j.Close()
+
+	// numbering carries on after a restart, from the state the car
+	// restarted in
+	j, err = OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted := NewElevator(0)
+	restarted.Floor = 1 << 6
+	j.Base(restarted.Snapshot())
+	j.Record(&messages.WalEntry{Entry: &messages.WalEntry_Step{Step: &messages.StepRequest{}}})
+	j.Close()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Error("Expected 0600, got ", info.Mode().Perm())
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "Signature") || strings.Contains(string(data), "signature") {
+		t.Error("Expected the signature to be left out, got ", string(data))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// time travel to the second entry
+	e := NewElevator(0)
+	entries := []*messages.JournalEntry{}
+	err = ReadJournal(f, func(entry *messages.JournalEntry) bool {
+		if entry.Index > 2 {
+			return false
+		}
+		entries = append(entries, entry)
+		e.ReplayJournal(entry)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatal("Expected 2 entries, got ", len(entries))
+	}
+	if entries[0].Principal != "alice" || entries[0].Role != "operator" || entries[0].Sender.Id != "client" || entries[0].Time == "" {
+		t.Error("Expected the first entry to carry sender and time, got ", entries[0])
+	}
+	if CommandName(entries[1].Command) != "multistep" {
+		t.Error("Expected a multistep, got ", CommandName(entries[1].Command))
+	}
+	if e.GetCurrentFloor() != 2 {
+		t.Error("Expected floor 2, got ", e.GetCurrentFloor())
+	}
+
+	// the base replaces what came before
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	e = NewElevator(0)
+	err = ReadJournal(f, func(entry *messages.JournalEntry) bool {
+		e.ReplayJournal(entry)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.GetCurrentFloor() != 6 {
+		t.Error("Expected floor 6, got ", e.GetCurrentFloor())
+	}
+}
@@ -0,0 +1,299 @@
+package elevator
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Messages the mailbox of an actor holds, senders block once it is full
+const MAILBOX_SIZE = 10000
+
+// Messages waiting before the overflow policy applies by default, well
+// below MAILBOX_SIZE as the mailbox blocks the senders once it is full
+const MAILBOX_LIMIT = 1000
+
+// Overflow policies, what an elevator does with the commands arriving
+// while its mailbox is over the limit
+const (
+	// Senders wait for room in the mailbox
+	OVERFLOW_BLOCK = "block"
+	// The command is refused with an error
+	OVERFLOW_REJECT = "reject"
+	// The oldest command waiting is refused in favour of the new one
+	OVERFLOW_DROP_OLDEST = "drop-oldest"
+	// A hall call already waiting in the mailbox is answered without
+	// being run again if the car still holds the call by the time it gets
+	// to it, other commands wait like with block
+	OVERFLOW_COALESCE = "coalesce"
+)
+
+// Overflow is the policy an elevator sheds commands with once more than
+// Limit messages wait in its mailbox. Commands are picked to be shed as
+// they are posted but answered once the elevator gets to them, they take
+// room in the mailbox until then. The zero value blocks.
+type Overflow struct {
+	Policy string
+	Limit  int
+}
+
+// ParseOverflow checks policy and defaults limit to MAILBOX_LIMIT, a limit
+// the mailbox cannot exceed is refused
+func ParseOverflow(policy string, limit int) (Overflow, error) {
+	switch policy {
+	case OVERFLOW_BLOCK, OVERFLOW_REJECT, OVERFLOW_DROP_OLDEST, OVERFLOW_COALESCE:
+	default:
+		return Overflow{}, fmt.Errorf("unknown overflow policy %q, expected block, reject, drop-oldest or coalesce", policy)
+	}
+	if limit <= 0 {
+		limit = MAILBOX_LIMIT
+	}
+	if limit >= MAILBOX_SIZE {
+		return Overflow{}, fmt.Errorf("mailbox limit %d must be below the mailbox size %d", limit, MAILBOX_SIZE)
+	}
+
+	return Overflow{Policy: policy, Limit: limit}, nil
+}
+
+func (o Overflow) blocks() bool {
+	return o.Policy == "" || o.Policy == OVERFLOW_BLOCK
+}
+
+// waiting is a command in the mailbox and the policy it was shed by, if
+// any
+type waiting struct {
+	message interface{}
+	shed    string
+}
+
+// MailboxCounter tracks the messages waiting in the mailbox of an actor,
+// it is handed to the mailbox as its statistics. Commands posted while
+// the mailbox is over the limit of Overflow are marked to be shed when
+// the elevator gets to them, the mailbox itself can only block.
+type MailboxCounter struct {
+	Overflow Overflow
+	// Gauge follows the depth when set
+	Gauge    prometheus.Gauge
+	posted   int64
+	received int64
+
+	mu sync.Mutex
+	// Commands waiting, oldest first, only tracked when shedding
+	commands []*waiting
+	// commands before it were already considered for dropping
+	cursor int
+	// Hall calls not shed among the commands, by floor and direction
+	calls map[hallCall]int
+}
+
+// hallCall is a pickup waiting in the mailbox
+type hallCall struct {
+	floor uint32
+	state int32
+}
+
+func (c *MailboxCounter) MailboxStarted() {}
+
+func (c *MailboxCounter) MessagePosted(message interface{}) {
+	depth := atomic.AddInt64(&c.posted, 1) - atomic.LoadInt64(&c.received)
+	c.observe(depth)
+
+	if c.Overflow.blocks() || !sheddable(message) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &waiting{message: message}
+	if depth > int64(c.Overflow.Limit) {
+		switch c.Overflow.Policy {
+		case OVERFLOW_REJECT:
+			w.shed = OVERFLOW_REJECT
+		case OVERFLOW_DROP_OLDEST:
+			c.dropOldest()
+		case OVERFLOW_COALESCE:
+			if call, ok := pickupCall(message); ok && c.calls[call] > 0 {
+				w.shed = OVERFLOW_COALESCE
+			}
+		}
+	}
+	c.commands = append(c.commands, w)
+	c.count(w, 1)
+}
+
+func (c *MailboxCounter) MessageReceived(message interface{}) {
+	received := atomic.AddInt64(&c.received, 1)
+	c.observe(atomic.LoadInt64(&c.posted) - received)
+}
+
+func (c *MailboxCounter) MailboxEmpty() {}
+
+// Depth is the amount of messages posted but not received yet
+func (c *MailboxCounter) Depth() int64 {
+	return atomic.LoadInt64(&c.posted) - atomic.LoadInt64(&c.received)
+}
+
+func (c *MailboxCounter) observe(depth int64) {
+	if c.Gauge != nil {
+		c.Gauge.Set(float64(depth))
+	}
+}
+
+// dropOldest marks the oldest command waiting that was not shed yet,
+// callers must hold mu
+func (c *MailboxCounter) dropOldest() {
+	for ; c.cursor < len(c.commands); c.cursor++ {
+		w := c.commands[c.cursor]
+		if w.shed == "" {
+			c.count(w, -1)
+			w.shed = OVERFLOW_DROP_OLDEST
+			c.cursor++
+			return
+		}
+	}
+}
+
+// count adds n to the hall calls of w when it is one not shed, callers
+// must hold mu
+func (c *MailboxCounter) count(w *waiting, n int) {
+	call, ok := pickupCall(w.message)
+	if !ok || w.shed != "" {
+		return
+	}
+	if c.calls == nil {
+		c.calls = make(map[hallCall]int)
+	}
+	c.calls[call] += n
+	if c.calls[call] == 0 {
+		delete(c.calls, call)
+	}
+}
+
+// take removes message from the commands waiting and returns the policy
+// it was shed by, empty when it is to be run. The elevator takes every
+// message it handles, nil counters shed nothing.
+func (c *MailboxCounter) take(message interface{}) string {
+	if c == nil || c.Overflow.blocks() || !sheddable(message) {
+		return ""
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, w := range c.commands {
+		if w.message != message {
+			continue
+		}
+		c.count(w, -1)
+		// Commands are taken in the order they were posted but for
+		// concurrent senders racing each other
+		if i == 0 {
+			c.commands[0] = nil
+			c.commands = c.commands[1:]
+		} else {
+			c.commands = append(c.commands[:i], c.commands[i+1:]...)
+		}
+		if i < c.cursor {
+			c.cursor--
+		}
+		return w.shed
+	}
+
+	return ""
+}
+
+// sheddable reports whether message is a command of a client, the
+// handshake and the messages of this process are never shed
+func sheddable(message interface{}) bool {
+	switch message.(type) {
+	case *messages.StatusRequest,
+		*messages.UpdateRequest,
+		*messages.PickupRequest,
+		*messages.DestinationPickupRequest,
+		*messages.CancelRequest,
+		*messages.BatchRequest,
+		*messages.StepRequest,
+		*messages.MultiStepRequest:
+		return true
+	}
+
+	return false
+}
+
+// pickupCall is the hall call of message when it is a pickup
+func pickupCall(message interface{}) (hallCall, bool) {
+	if msg, ok := message.(*messages.PickupRequest); ok {
+		return hallCall{floor: msg.Floor, state: msg.State}, true
+	}
+
+	return hallCall{}, false
+}
+
+// messageSender is the client to answer a sheddable message
+func messageSender(message interface{}) *actor.PID {
+	switch msg := message.(type) {
+	case *messages.StatusRequest:
+		return msg.Sender
+	case *messages.UpdateRequest:
+		return msg.Sender
+	case *messages.PickupRequest:
+		return msg.Sender
+	case *messages.DestinationPickupRequest:
+		return msg.Sender
+	case *messages.CancelRequest:
+		return msg.Sender
+	case *messages.BatchRequest:
+		return msg.Sender
+	case *messages.StepRequest:
+		return msg.Sender
+	case *messages.MultiStepRequest:
+		return msg.Sender
+	}
+
+	return nil
+}
+
+// shed answers a command the overflow policy picked without running it
+func (e *Elevator) shed(message interface{}) bool {
+	policy := e.Mailbox.take(message)
+	if policy == OVERFLOW_COALESCE && !e.holds(message.(*messages.PickupRequest)) {
+		// The call it rode on was served or refused meanwhile
+		policy = ""
+	}
+	if policy == "" {
+		return false
+	}
+	if e.Meter != nil {
+		e.Meter.shed(policy)
+	}
+
+	sender := messageSender(message)
+	switch policy {
+	case OVERFLOW_REJECT:
+		e.refuse(sender, fmt.Errorf("elevator %d is overloaded, try again later", e.Id))
+	case OVERFLOW_DROP_OLDEST:
+		e.refuse(sender, fmt.Errorf("elevator %d dropped the command, its mailbox is full", e.Id))
+	case OVERFLOW_COALESCE:
+		// Rides on the same call waiting in the mailbox
+		msg := message.(*messages.PickupRequest)
+		// Replayed like a pickup so the listener survives a restart
+		if !e.admit(msg) {
+			return true
+		}
+		e.Listen(uint16(msg.Floor), msg.Sender)
+		e.reply(msg.Sender, e.newStatusResponse())
+	}
+
+	return true
+}
+
+// holds reports whether the hall call of msg is still pending on the car
+func (e *Elevator) holds(msg *messages.PickupRequest) bool {
+	direction, ok := e.HallCalls[uint16(msg.Floor)]
+
+	return ok && direction == int(msg.State)
+}
@@ -0,0 +1,114 @@
+package elevator
+
+import (
+	"testing"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func TestParseOverflow(t *testing.T) {
+	overflow, err := ParseOverflow(OVERFLOW_REJECT, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overflow.Limit != MAILBOX_LIMIT {
+		t.Error("Expected ", MAILBOX_LIMIT, ", got ", overflow.Limit)
+	}
+	// never reached, the mailbox blocks first
+	if _, err := ParseOverflow(OVERFLOW_REJECT, MAILBOX_SIZE); err == nil {
+		t.Error("Expected an error for a limit at the mailbox size")
+	}
+	if _, err := ParseOverflow("drop-newest", 10); err == nil {
+		t.Error("Expected an error for an unknown policy")
+	}
+}
+
+func TestOverflowReject(t *testing.T) {
+	c := &MailboxCounter{Overflow: Overflow{Policy: OVERFLOW_REJECT, Limit: 1}}
+	first := &messages.StepRequest{}
+	second := &messages.StepRequest{}
+	c.MessagePosted(first)
+	c.MessagePosted(second)
+	// never shed
+	c.MessagePosted(&messages.HelloRequest{})
+
+	if c.Depth() != 3 {
+		t.Error("Expected 3, got ", c.Depth())
+	}
+	if v := c.take(first); v != "" {
+		t.Error("Expected the first command to run, got ", v)
+	}
+	if v := c.take(second); v != OVERFLOW_REJECT {
+		t.Error("Expected ", OVERFLOW_REJECT, ", got ", v)
+	}
+}
+
+func TestOverflowDropOldest(t *testing.T) {
+	c := &MailboxCounter{Overflow: Overflow{Policy: OVERFLOW_DROP_OLDEST, Limit: 2}}
+	commands := []*messages.StepRequest{{}, {}, {}, {}}
+	for _, command := range commands {
+		c.MessagePosted(command)
+	}
+
+	expected := []string{OVERFLOW_DROP_OLDEST, OVERFLOW_DROP_OLDEST, "", ""}
+	for i, command := range commands {
+		if v := c.take(command); v != expected[i] {
+			t.Error("Expected ", expected[i], " for command ", i, ", got ", v)
+		}
+		c.MessageReceived(command)
+	}
+	if c.Depth() != 0 {
+		t.Error("Expected 0, got ", c.Depth())
+	}
+}
+
+func TestOverflowCoalesce(t *testing.T) {
+	c := &MailboxCounter{Overflow: Overflow{Policy: OVERFLOW_COALESCE, Limit: 1}}
+	first := &messages.PickupRequest{Floor: 3, State: DESCENDING}
+	duplicate := &messages.PickupRequest{Floor: 3, State: DESCENDING}
+	other := &messages.PickupRequest{Floor: 3, State: ASCENDING}
+	c.MessagePosted(first)
+	c.MessagePosted(duplicate)
+	c.MessagePosted(other)
+
+	if v := c.take(first); v != "" {
+		t.Error("Expected the first call to run, got ", v)
+	}
+	if v := c.take(duplicate); v != OVERFLOW_COALESCE {
+		t.Error("Expected ", OVERFLOW_COALESCE, ", got ", v)
+	}
+	if v := c.take(other); v != "" {
+		t.Error("Expected a call the other way to run, got ", v)
+	}
+}
+
+func TestShedCoalesce(t *testing.T) {
+	e := NewElevator(0)
+	e.Mailbox = &MailboxCounter{Overflow: Overflow{Policy: OVERFLOW_COALESCE, Limit: 1}}
+	sender := actor.NewLocalPID("client")
+	first := &messages.PickupRequest{Sender: sender, Floor: 3, State: DESCENDING}
+	duplicate := &messages.PickupRequest{Sender: sender, Floor: 3, State: DESCENDING}
+	late := &messages.PickupRequest{Sender: sender, Floor: 3, State: DESCENDING}
+	e.Mailbox.MessagePosted(first)
+	e.Mailbox.MessagePosted(duplicate)
+	e.Mailbox.MessagePosted(late)
+
+	if e.shed(first) {
+		t.Error("Expected the first call to run")
+	}
+	e.Pickup(3, DESCENDING)
+	if !e.shed(duplicate) {
+		t.Error("Expected the duplicate to ride on the pending call")
+	}
+	if len(e.Listeners[3]) != 1 {
+		t.Error("Expected the duplicate to listen at floor 3, got ", len(e.Listeners[3]))
+	}
+
+	// served before the car got to the last one
+	e.Cancel(3)
+	if e.shed(late) {
+		t.Error("Expected a call no longer pending to run")
+	}
+}
@@ -0,0 +1,102 @@
+package elevator
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tripsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "elevator_trips_total",
+		Help: "Trips started by the car, a trip runs from leaving idle until it is idle again.",
+	}, []string{"elevator"})
+	stopsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "elevator_stops_total",
+		Help: "Goals the car stopped at.",
+	}, []string{"elevator"})
+	floorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "elevator_floors_traveled_total",
+		Help: "Floors the car moved past.",
+	}, []string{"elevator"})
+	stateSeconds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "elevator_state_seconds_total",
+		Help: "Time the car spent idle, ascending and descending, up to the last message it handled.",
+	}, []string{"elevator", "state"})
+	mailboxDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "elevator_mailbox_depth",
+		Help: "Messages waiting in the mailbox of the car.",
+	}, []string{"elevator"})
+	shedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "elevator_commands_shed_total",
+		Help: "Commands answered without running them while the mailbox was over the limit, by overflow policy.",
+	}, []string{"elevator", "policy"})
+)
+
+func init() {
+	prometheus.MustRegister(tripsTotal, stopsTotal, floorsTotal, stateSeconds, mailboxDepth, shedTotal)
+}
+
+func stateName(state int) string {
+	switch state {
+	case ASCENDING:
+		return "ascending"
+	case DESCENDING:
+		return "descending"
+	}
+
+	return "idle"
+}
+
+// Meter follows the state of one car between messages
+type Meter struct {
+	id    string
+	state int
+	since time.Time
+}
+
+func NewMeter(id uint) *Meter {
+	return &Meter{
+		id:    strconv.FormatUint(uint64(id), 10),
+		state: IDLE,
+		since: time.Now(),
+	}
+}
+
+// observe accounts the time spent in the previous state and counts a trip
+// when the car leaves idle
+func (m *Meter) observe(state int) {
+	now := time.Now()
+	stateSeconds.WithLabelValues(m.id, stateName(m.state)).Add(now.Sub(m.since).Seconds())
+	if m.state == IDLE && state != IDLE {
+		tripsTotal.WithLabelValues(m.id).Inc()
+	}
+	m.state, m.since = state, now
+}
+
+// resume picks up the state a car was restored or restarted in, it is
+// not a trip of its own
+func (m *Meter) resume(state int) {
+	m.state = state
+}
+
+func (m *Meter) moved() {
+	floorsTotal.WithLabelValues(m.id).Inc()
+}
+
+func (m *Meter) stopped() {
+	stopsTotal.WithLabelValues(m.id).Inc()
+}
+
+func (m *Meter) shed(policy string) {
+	shedTotal.WithLabelValues(m.id, policy).Inc()
+}
+
+// NewMailboxCounter tracks the mailbox of elevator id on the depth gauge
+func NewMailboxCounter(id uint, overflow Overflow) *MailboxCounter {
+	return &MailboxCounter{
+		Overflow: overflow,
+		Gauge:    mailboxDepth.WithLabelValues(strconv.FormatUint(uint64(id), 10)),
+	}
+}
@@ -0,0 +1,27 @@
+package elevator
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMeter(t *testing.T) {
+	e := NewElevator(42)
+	e.Meter = NewMeter(42)
+
+	e.Pickup(3, DESCENDING)
+	e.Meter.observe(e.State)
+	e.Steps(0, true)
+	e.Meter.observe(e.State)
+
+	if v := testutil.ToFloat64(tripsTotal.WithLabelValues("42")); v != 1 {
+		t.Error("Expected 1, got ", v)
+	}
+	if v := testutil.ToFloat64(stopsTotal.WithLabelValues("42")); v != 1 {
+		t.Error("Expected 1, got ", v)
+	}
+	if v := testutil.ToFloat64(floorsTotal.WithLabelValues("42")); v != 3 {
+		t.Error("Expected 3, got ", v)
+	}
+}
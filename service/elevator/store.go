@@ -0,0 +1,325 @@
+package elevator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Entries logged before the log is folded into a new snapshot
+const SNAPSHOT_INTERVAL = 1024
+
+const (
+	SNAPSHOT_FILE = "snapshot"
+	WAL_FILE      = "wal"
+)
+
+// Store persists an elevator to a directory as a snapshot plus a
+// write-ahead log of the commands applied since. Entries are numbered,
+// the snapshot remembers the last one it contains so a crash between
+// writing a snapshot and truncating the log replays nothing twice.
+// The entry appended last is uncommitted until Commit, Rollback drops it
+// when the command it holds crashed the car. Entries carry the principals
+// of the commands, the files are readable by their owner only.
+type Store struct {
+	Dir     string
+	wal     *os.File
+	index   uint64
+	entries int
+	// Size of the log, and before the uncommitted entry if any
+	size        int64
+	uncommitted int64
+	pending     bool
+}
+
+func OpenStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	wal, err := os.OpenFile(filepath.Join(dir, WAL_FILE), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{Dir: dir, wal: wal}, nil
+}
+
+// Load restores e from the snapshot and replays the log on top of it.
+// A record cut short by a crash is dropped from the log.
+func (s *Store) Load(e *Elevator) error {
+	data, err := ioutil.ReadFile(filepath.Join(s.Dir, SNAPSHOT_FILE))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		snapshot := &messages.Snapshot{}
+		if err := proto.Unmarshal(data, snapshot); err != nil {
+			return fmt.Errorf("corrupt snapshot: %v", err)
+		}
+		e.Restore(snapshot)
+		s.index = snapshot.Index
+	}
+
+	if _, err := s.wal.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	var valid int64
+	s.entries = 0
+	for {
+		entry, n, err := readEntry(s.wal)
+		if err != nil {
+			break
+		}
+		valid += n
+		s.entries++
+
+		// Already part of the snapshot
+		if entry.Index <= s.index {
+			continue
+		}
+		e.Replay(entry)
+		s.index = entry.Index
+	}
+
+	s.size = valid
+	s.pending = false
+
+	return s.wal.Truncate(valid)
+}
+
+// readEntry reads one length prefixed entry and its size on disk
+func readEntry(r io.Reader) (*messages.WalEntry, int64, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, 0, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, 0, err
+	}
+
+	entry := &messages.WalEntry{}
+	if err := proto.Unmarshal(data, entry); err != nil {
+		return nil, 0, err
+	}
+
+	return entry, int64(4 + size), nil
+}
+
+// Append numbers entry and writes it to disk before it is applied. Every
+// entry is synced on its own, so a command costs the car an fsync before
+// it is answered, see BenchmarkAppend. Commands are not batched, the car
+// handles one at a time and answers it before taking the next.
+func (s *Store) Append(entry *messages.WalEntry) error {
+	entry.Index = s.index + 1
+
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+
+	if _, err := s.wal.Write(buf); err != nil {
+		return err
+	}
+	if err := s.wal.Sync(); err != nil {
+		return err
+	}
+	s.index = entry.Index
+	s.entries++
+	s.uncommitted = s.size
+	s.size += int64(len(buf))
+	s.pending = true
+
+	return nil
+}
+
+// Commit keeps the entry appended last, its command ran to the end
+func (s *Store) Commit() {
+	s.pending = false
+}
+
+// Rollback drops the entry appended last unless it was committed, so a
+// command that crashed the car is not replayed when it is restored
+func (s *Store) Rollback() error {
+	if !s.pending {
+		return nil
+	}
+	if err := s.wal.Truncate(s.uncommitted); err != nil {
+		return err
+	}
+	s.size = s.uncommitted
+	s.pending = false
+	s.index--
+	s.entries--
+
+	return nil
+}
+
+// Due reports whether the log grew long enough for a snapshot
+func (s *Store) Due() bool {
+	return s.entries >= SNAPSHOT_INTERVAL
+}
+
+// Snapshot saves e and empties the log
+func (s *Store) Snapshot(e *Elevator) error {
+	snapshot := e.Snapshot()
+	snapshot.Index = s.index
+
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.Dir, SNAPSHOT_FILE)
+	tmp, err := os.OpenFile(path+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	if err := s.wal.Truncate(0); err != nil {
+		return err
+	}
+	s.entries = 0
+	s.size = 0
+	s.pending = false
+
+	return nil
+}
+
+func (s *Store) Close() error {
+	return s.wal.Close()
+}
+
+// Snapshot captures the state of the car
+func (e *Elevator) Snapshot() *messages.Snapshot {
+	snapshot := &messages.Snapshot{
+		BitVector:         uint32(e.BitVector),
+		Floor:             uint32(e.Floor),
+		State:             int32(e.State),
+		LockedPickupFloor: uint32(e.LockedPickupFloor),
+		LockedDirection:   int32(e.LockedDirection),
+		CarCalls:          uint32(e.CarCalls),
+		Draining:          e.Draining,
+		Faulted:           e.Faulted,
+	}
+
+	keys := []string{}
+	for key := range e.Clients {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		snapshot.Clients = append(snapshot.Clients, e.Clients[key])
+	}
+
+	for floor := uint16(0); floor < uint16(messages.FLOORS); floor++ {
+		for _, pid := range e.Listeners[floor] {
+			snapshot.Listeners = append(snapshot.Listeners, &messages.Listener{
+				Floor: uint32(floor),
+				Pid:   pid,
+			})
+		}
+		for _, call := range e.PendingCalls[floor] {
+			snapshot.PendingCalls = append(snapshot.PendingCalls, &messages.PendingCall{
+				Floor:  uint32(floor),
+				Goal:   uint32(call.Goal),
+				Sender: call.Sender,
+			})
+		}
+		if direction, ok := e.HallCalls[floor]; ok {
+			snapshot.HallCalls = append(snapshot.HallCalls, &messages.HallCall{
+				Floor: uint32(floor),
+				State: int32(direction),
+			})
+		}
+	}
+
+	return snapshot
+}
+
+// Restore puts the car back into the state of snapshot
+func (e *Elevator) Restore(snapshot *messages.Snapshot) {
+	e.BitVector = uint16(snapshot.BitVector)
+	e.Floor = uint16(snapshot.Floor)
+	e.State = int(snapshot.State)
+	e.LockedPickupFloor = uint16(snapshot.LockedPickupFloor)
+	e.LockedDirection = int(snapshot.LockedDirection)
+
+	e.Listeners = make(map[uint16][]*actor.PID)
+	for _, l := range snapshot.Listeners {
+		e.Listeners[uint16(l.Floor)] = append(e.Listeners[uint16(l.Floor)], l.Pid)
+	}
+	e.PendingCalls = make(map[uint16][]CarCall)
+	for _, c := range snapshot.PendingCalls {
+		e.PendingCalls[uint16(c.Floor)] = append(e.PendingCalls[uint16(c.Floor)], CarCall{
+			Goal:   uint16(c.Goal),
+			Sender: c.Sender,
+		})
+	}
+	e.HallCalls = make(map[uint16]int)
+	for _, c := range snapshot.HallCalls {
+		e.HallCalls[uint16(c.Floor)] = int(c.State)
+	}
+	e.CarCalls = uint16(snapshot.CarCalls)
+	e.Draining = snapshot.Draining
+	e.Faulted = snapshot.Faulted
+
+	e.Clients = make(map[string]*actor.PID)
+	for _, pid := range snapshot.Clients {
+		e.Clients[clientKey(pid)] = pid
+	}
+}
+
+// Replay applies a logged command again without telling anyone
+func (e *Elevator) Replay(entry *messages.WalEntry) {
+	e.Replaying = true
+	defer func() { e.Replaying = false }()
+
+	switch c := entry.Entry.(type) {
+	case *messages.WalEntry_Update:
+		e.Update(int(c.Update.Goal), int(c.Update.State))
+		e.Listen(uint16(c.Update.Goal), c.Update.Sender)
+	case *messages.WalEntry_Pickup:
+		e.Pickup(uint16(c.Pickup.Floor), int(c.Pickup.State))
+		e.Listen(uint16(c.Pickup.Floor), c.Pickup.Sender)
+	case *messages.WalEntry_DestinationPickup:
+		e.DestinationPickup(uint16(c.DestinationPickup.Floor), uint16(c.DestinationPickup.Destination), c.DestinationPickup.Sender)
+	case *messages.WalEntry_Cancel:
+		e.Cancel(uint16(c.Cancel.Floor))
+	case *messages.WalEntry_Batch:
+		e.Batch(c.Batch.Commands, c.Batch.Sender)
+	case *messages.WalEntry_Step:
+		e.Step()
+	case *messages.WalEntry_MultiStep:
+		e.Steps(int(c.MultiStep.Count), c.MultiStep.UntilIdle)
+	}
+}
@@ -0,0 +1,193 @@
+package elevator
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// apply logs and runs entry the way Receive does
+func apply(t *testing.T, e *Elevator, entry *messages.WalEntry) {
+	if err := e.Store.Append(entry); err != nil {
+		t.Fatal(err)
+	}
+	e.Replay(entry)
+}
+
+func reopen(t *testing.T, dir string) *Elevator {
+	store, err := OpenStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewElevator(0)
+	if err := store.Load(e); err != nil {
+		t.Fatal(err)
+	}
+	e.Store = store
+
+	return e
+}
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := reopen(t, dir)
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_Pickup{Pickup: &messages.PickupRequest{Floor: 5, State: DESCENDING}}})
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_MultiStep{MultiStep: &messages.MultiStepRequest{Count: 2}}})
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_DestinationPickup{DestinationPickup: &messages.DestinationPickupRequest{Floor: 9, Destination: 12}}})
+	want := e.Snapshot()
+	e.Store.Close()
+
+	// the log alone restores the car
+	e = reopen(t, dir)
+	if got := e.Snapshot(); !proto.Equal(got, want) {
+		t.Error("Expected ", want, ", got ", got)
+	}
+
+	// entries after a snapshot are replayed on top of it
+	if err := e.Store.Snapshot(e); err != nil {
+		t.Fatal(err)
+	}
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_Step{Step: &messages.StepRequest{}}})
+	want = e.Snapshot()
+	e.Store.Close()
+
+	// a record cut short by a crash is dropped
+	wal, err := os.OpenFile(filepath.Join(dir, WAL_FILE), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wal.Write([]byte{0, 0, 0, 42, 1})
+	wal.Close()
+
+	e = reopen(t, dir)
+	if got := e.Snapshot(); !proto.Equal(got, want) {
+		t.Error("Expected ", want, ", got ", got)
+	}
+	if e.GetCurrentFloor() != 3 {
+		t.Error("Expected floor 3, got ", e.GetCurrentFloor())
+	}
+	e.Store.Close()
+}
+
+func TestSnapshotClients(t *testing.T) {
+	e := NewElevator(0)
+	e.Clients["127.0.0.1:8000/client"] = actor.NewPID("127.0.0.1:8000", "client")
+	e.Update(5, ASCENDING)
+	e.Pickup(9, DESCENDING)
+
+	restored := NewElevator(0)
+	restored.Restore(e.Snapshot())
+	if len(restored.Clients) != 1 || restored.Clients["127.0.0.1:8000/client"] == nil {
+		t.Error("Expected the client to be restored, got ", restored.Clients)
+	}
+	if restored.CarCalls != (1 << 5) {
+		t.Error("Expected the car call to floor 5, got ", restored.CarCalls)
+	}
+
+	// clients of the snapshot replace the ones known before
+	restored.Restore(NewElevator(0).Snapshot())
+	if len(restored.Clients) != 0 {
+		t.Error("Expected no clients, got ", restored.Clients)
+	}
+}
+
+func TestSnapshotOutOfService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := reopen(t, dir)
+	e.Draining = true
+	e.Faulted = true
+	if err := e.Store.Snapshot(e); err != nil {
+		t.Fatal(err)
+	}
+	e.Store.Close()
+
+	e = reopen(t, dir)
+	if !e.Draining || !e.Faulted {
+		t.Error("Expected the car to stay out of service, got ", e.Draining, e.Faulted)
+	}
+	e.Store.Close()
+
+	// the log holds principals
+	for _, file := range []string{SNAPSHOT_FILE, WAL_FILE} {
+		info, err := os.Stat(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Error("Expected ", file, " to be private, got ", info.Mode().Perm())
+		}
+	}
+}
+
+func BenchmarkAppend(b *testing.B) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := OpenStore(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer store.Close()
+
+	for i := 0; i < b.N; i++ {
+		entry := &messages.WalEntry{Entry: &messages.WalEntry_Pickup{Pickup: &messages.PickupRequest{Floor: 5, State: DESCENDING}}}
+		if err := store.Append(entry); err != nil {
+			b.Fatal(err)
+		}
+		store.Commit()
+	}
+}
+
+func TestStoreRollback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := reopen(t, dir)
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_Pickup{Pickup: &messages.PickupRequest{Floor: 5, State: DESCENDING}}})
+	e.Store.Commit()
+	// committed entries stay
+	if err := e.Store.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the command crashed the car before it applied
+	if err := e.Store.Append(&messages.WalEntry{Entry: &messages.WalEntry_Pickup{Pickup: &messages.PickupRequest{Floor: 9, State: ASCENDING}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Store.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	apply(t, e, &messages.WalEntry{Entry: &messages.WalEntry_Step{Step: &messages.StepRequest{}}})
+	e.Store.Close()
+
+	e = reopen(t, dir)
+	if _, ok := e.HallCalls[9]; ok {
+		t.Error("Expected the pickup at floor 9 to be dropped")
+	}
+	if _, ok := e.HallCalls[5]; !ok || e.GetCurrentFloor() != 1 {
+		t.Error("Expected floor 1 heading for floor 5, got ", e.GetCurrentFloor())
+	}
+	e.Store.Close()
+}
@@ -0,0 +1,159 @@
+package elevator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"dec/internal/logging"
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	log "github.com/sirupsen/logrus"
+)
+
+// Crashes tolerated within RESTART_WINDOW before the car is taken out of
+// service
+const (
+	MAX_RESTARTS   = 5
+	RESTART_WINDOW = time.Minute
+)
+
+// Supervision restarts an elevator actor that panicked and carries the
+// car over to the new actor. The state after the last message handled
+// without a panic is kept in memory, so a restart resumes from there
+// rather than from disk and the command that crashed is dropped. Too
+// many crashes in a row take the car out of service and hand its hall
+// calls to the clients.
+type Supervision struct {
+	Id       uint
+	mu       sync.Mutex
+	restarts int
+	failures []time.Time
+	reason   string
+	faulted  bool
+	// state after the last message handled without a panic
+	good *messages.Snapshot
+	// what the crashed actor knew besides the state of the car
+	clients     map[string]*actor.PID
+	lastCommand *CommandRecord
+}
+
+func NewSupervision(id uint) *Supervision {
+	return &Supervision{Id: id}
+}
+
+// HandleFailure restarts the crashed actor, faulting the car once it
+// crashed more than MAX_RESTARTS times within RESTART_WINDOW
+func (s *Supervision) HandleFailure(supervisor actor.Supervisor, child *actor.PID, rs *actor.RestartStatistics, reason interface{}, message interface{}) {
+	now := time.Now()
+
+	s.mu.Lock()
+	s.restarts++
+	s.reason = fmt.Sprint(reason)
+	recent := []time.Time{}
+	for _, t := range s.failures {
+		if now.Sub(t) < RESTART_WINDOW {
+			recent = append(recent, t)
+		}
+	}
+	s.failures = append(recent, now)
+	if len(s.failures) > MAX_RESTARTS {
+		s.faulted = true
+	}
+	restarts, failures, faulted := s.restarts, len(s.failures), s.faulted
+	s.mu.Unlock()
+
+	logging.Elevator(s.Id).WithFields(log.Fields{
+		"message": fmt.Sprintf("%T", message),
+		"restart": restarts,
+	}).Errorf("crashed: %v", reason)
+	if faulted {
+		logging.Elevator(s.Id).WithFields(log.Fields{
+			"crashes": failures,
+			"window":  RESTART_WINDOW.String(),
+		}).Error("crashing too often, taking it out of service")
+	}
+
+	supervisor.RestartChildren(child)
+}
+
+// Restarts is the amount of times the actor was restarted
+func (s *Supervision) Restarts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.restarts
+}
+
+// keep remembers the state of e as the one to restart from
+func (s *Supervision) keep(e *Elevator) {
+	snapshot := e.Snapshot()
+
+	s.mu.Lock()
+	s.good = snapshot
+	s.mu.Unlock()
+}
+
+// handover takes what the crashed actor knew besides the state of the car
+func (s *Supervision) handover(e *Elevator) {
+	s.mu.Lock()
+	s.clients = e.Clients
+	s.lastCommand = e.LastCommand
+	s.mu.Unlock()
+}
+
+// resume puts e into the state of the actor it replaces and reports
+// whether that state was known, the car is restored from disk otherwise
+func (s *Supervision) resume(e *Elevator) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.restarts == 0 {
+		return false
+	}
+
+	if s.good != nil {
+		e.Restore(s.good)
+	}
+	if s.clients != nil {
+		e.Clients = s.clients
+	}
+	e.LastCommand = s.lastCommand
+	e.Faulted = s.faulted
+
+	return s.good != nil
+}
+
+// Reason is why the actor crashed last
+func (s *Supervision) Reason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.reason
+}
+
+// fault drops the hall calls of a car taken out of service and tells the
+// clients to hand them to other cars
+func (e *Elevator) fault() {
+	drain := e.Drain()
+	event := &messages.FaultEvent{
+		Id:        uint32(e.Id),
+		Reason:    e.Supervision.Reason(),
+		Restarts:  uint32(e.Supervision.Restarts()),
+		HallCalls: drain.HallCalls,
+	}
+	for _, pid := range e.Clients {
+		pid.Tell(event)
+	}
+}
+
+// faulty refuses every command once the car is out of service
+func (e *Elevator) faulty(sender *actor.PID) bool {
+	if !e.Faulted {
+		return false
+	}
+	e.refuse(sender, fmt.Errorf("elevator %d is out of service", e.Id))
+
+	return true
+}
@@ -0,0 +1,80 @@
+package elevator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"dec/messages"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func crash() *messages.BatchRequest {
+	return &messages.BatchRequest{Commands: []*messages.Command{
+		{Command: &messages.Command_Update{}},
+	}}
+}
+
+func TestSupervision(t *testing.T) {
+	faults := make(chan *messages.FaultEvent, 1)
+	client := actor.Spawn(actor.FromFunc(func(c actor.Context) {
+		if msg, ok := c.Message().(*messages.FaultEvent); ok {
+			faults <- msg
+		}
+	}))
+	defer client.Stop()
+
+	supervision := NewSupervision(0)
+	props := actor.FromProducer(newElevatorActor(NewElevator(0), math.MaxUint16, nil, nil, nil, supervision, nil)).
+		WithGuardian(supervision)
+	pid := actor.Spawn(props)
+	defer pid.Stop()
+
+	pid.Tell(&messages.HelloRequest{Sender: client, Version: messages.PROTOCOL_VERSION})
+	pid.Tell(&messages.PickupRequest{Sender: client, Floor: 3, State: DESCENDING})
+
+	// an update without its fields crashes the actor
+	pid.Tell(crash())
+	res, err := pid.RequestFuture(&inspectRequest{}, PROBE_TIMEOUT).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := res.(*Inspection)
+	if i.Restarts != 1 {
+		t.Error("Expected 1, got ", i.Restarts)
+	}
+	if i.BitVector != "0000000000001000" {
+		t.Error("Expected the pickup at floor 3 without the update, got ", i.BitVector)
+	}
+	if len(i.Clients) != 1 {
+		t.Error("Expected the client to be kept, got ", i.Clients)
+	}
+
+	for n := 1; n <= MAX_RESTARTS; n++ {
+		pid.Tell(crash())
+	}
+
+	select {
+	case event := <-faults:
+		if event.Restarts != MAX_RESTARTS+1 {
+			t.Error("Expected ", MAX_RESTARTS+1, " restarts, got ", event.Restarts)
+		}
+		if len(event.HallCalls) != 1 || event.HallCalls[0].Floor != 3 {
+			t.Error("Expected the pickup at floor 3 to be handed off, got ", event.HallCalls)
+		}
+	case <-time.After(PROBE_TIMEOUT):
+		t.Fatal("Expected a fault event")
+	}
+
+	// a faulted car refuses calls
+	pid.Tell(&messages.PickupRequest{Sender: client, Floor: 5, State: ASCENDING})
+	res, err = pid.RequestFuture(&inspectRequest{}, PROBE_TIMEOUT).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	i = res.(*Inspection)
+	if !i.Faulted || i.BitVector != "0000000000000000" {
+		t.Error("Expected a faulted car without calls, got ", i.Faulted, i.BitVector)
+	}
+}
@@ -0,0 +1,48 @@
+package elevator
+
+import (
+	"context"
+	"reflect"
+
+	"dec/internal/tracing"
+	"dec/messages"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan follows msg into the elevator when its sender traced it, the
+// span does nothing otherwise
+func (e *Elevator) startSpan(msg interface{}) trace.Span {
+	m, ok := msg.(messages.Traced)
+	if !ok || m.GetTraceParent() == "" {
+		return trace.SpanFromContext(context.Background())
+	}
+
+	ctx := tracing.Extract(context.Background(), m.GetTraceParent())
+	_, span := tracing.Tracer().Start(ctx, "elevator."+reflect.TypeOf(msg).Elem().Name(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.Int64("elevator.id", int64(e.Id))),
+	)
+
+	return span
+}
+
+// endSpan records the state the car was left in by the message
+func (e *Elevator) endSpan() {
+	e.span.SetAttributes(
+		attribute.Int64("floor", int64(e.GetCurrentFloor())),
+		attribute.Int64("state", int64(e.State)),
+	)
+	e.span.End()
+}
+
+// spanError marks the message being handled as refused
+func (e *Elevator) spanError(err error) {
+	if e.span == nil {
+		return
+	}
+	e.span.RecordError(err)
+	e.span.SetStatus(codes.Error, err.Error())
+}
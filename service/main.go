@@ -1,20 +1,159 @@
 package main
 
 import (
+	"crypto/ed25519"
+	"dec/internal/auth"
+	"dec/internal/ids"
+	"dec/internal/logging"
+	"dec/internal/mtls"
+	"dec/internal/tracing"
 	"dec/service/elevator"
 	"flag"
-	console "github.com/AsynkronIT/goconsole"
+	"math"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/remote"
+	log "github.com/sirupsen/logrus"
 )
 
 var flagBind = flag.String("bind", "127.0.0.1:9000", "Bind to address")
 var flagID = flag.Uint("id", 0, "ID")
+var flagIDs = flag.String("ids", "", "Host these elevators in one process, e.g. 0-7 or 0,2,4, only --id when empty")
+var flagCert = flag.String("cert", "", "TLS certificate presented to peers")
+var flagKey = flag.String("key", "", "TLS key of the certificate")
+var flagCA = flag.String("ca", "", "CA the certificates of peers must be issued by")
+var flagAuthKey = flag.String("auth-key-file", "", "Public key of the authority issuing principals, authorization is off when empty")
+var flagServed = flag.String("served", "", "Comma separated floors served by the car, all when empty")
+var flagJournal = flag.String("journal", "", "Append every applied command to this file, off when empty")
+var flagDrainTimeout = flag.Duration("drain-timeout", 30*time.Second, "Time allowed to drain on SIGTERM or SIGINT before exiting")
+var flagFinishCarCalls = flag.Bool("finish-car-calls", false, "Wait for the car calls to be served while draining")
+var flagHealth = flag.String("health", "", "Serve liveness, readiness, introspection and metrics over HTTP on address")
+var flagLogLevel = flag.String("log-level", "info", "Log entries from this level up: debug, info, warn or error")
+var flagLogFormat = flag.String("log-format", "text", "Log as text or json")
+var flagTrace = flag.String("trace", "", "Export spans to stdout or append them to this file, off when empty")
+var flagOverflow = flag.String("overflow", "block", "What to do with commands once the mailbox is over --mailbox-limit: block, reject, drop-oldest or coalesce")
+var flagMailboxLimit = flag.Int("mailbox-limit", elevator.MAILBOX_LIMIT, "Messages waiting in the mailbox of a car before the overflow policy applies, below the mailbox size of 10000")
+var flagDataDir = flag.String("data-dir", "", "Persist the car to this directory and restore it on start, off when empty")
+
+func parseServedFloors(served string) uint16 {
+	if served == "" {
+		return math.MaxUint16
+	}
+
+	var servedFloors uint16
+	for _, part := range strings.Split(served, ",") {
+		floor, err := strconv.ParseUint(strings.TrimSpace(part), 10, 4)
+		if err != nil {
+			log.WithField("floor", part).Fatal("invalid served floor")
+		}
+		servedFloors |= (1 << floor)
+	}
+
+	return servedFloors
+}
 
 func main() {
 	flag.Parse()
+	if err := logging.Setup(*flagLogLevel, *flagLogFormat); err != nil {
+		log.WithError(err).Fatal("logging setup failed")
+	}
+
+	options, err := mtls.RemotingOptions(*flagCert, *flagKey, *flagCA)
+	if err != nil {
+		log.WithError(err).Fatal("tls setup failed")
+	}
+
+	overflow, err := elevator.ParseOverflow(*flagOverflow, *flagMailboxLimit)
+	if err != nil {
+		log.WithError(err).Fatal("invalid overflow policy")
+	}
+
+	var authority ed25519.PublicKey
+	if *flagAuthKey != "" {
+		authority, err = auth.LoadAuthorityKey(*flagAuthKey)
+		if err != nil {
+			log.WithError(err).Fatal("auth setup failed")
+		}
+	}
+
+	list := []uint{*flagID}
+	if *flagIDs != "" {
+		list, err = ids.Parse(*flagIDs)
+		if err != nil {
+			log.WithError(err).Fatal("invalid ids")
+		}
+	}
+	name := "elevator " + strconv.FormatUint(uint64(list[0]), 10)
+	if len(list) > 1 {
+		name = "elevators " + *flagIDs
+	}
+
+	// Several cars keep their state apart, in a directory and journal each
+	persistence := func(id uint) (*elevator.Store, *elevator.Journal, error) {
+		var store *elevator.Store
+		var journal *elevator.Journal
+		var err error
+
+		if *flagDataDir != "" {
+			dir := *flagDataDir
+			if len(list) > 1 {
+				dir = filepath.Join(dir, strconv.FormatUint(uint64(id), 10))
+			}
+			store, err = elevator.OpenStore(dir)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 
-	elevator.NewElevatorService(*flagBind, *flagID)
+		if *flagJournal != "" {
+			path := *flagJournal
+			if len(list) > 1 {
+				path += "." + strconv.FormatUint(uint64(id), 10)
+			}
+			journal, err = elevator.OpenJournal(path)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		return store, journal, nil
+	}
+
+	stopTracing, err := tracing.Setup("elevator", *flagTrace)
+	if err != nil {
+		log.WithError(err).Fatal("tracing setup failed")
+	}
 
-	for {
-		console.ReadLine()
+	services, err := elevator.NewElevatorService(*flagBind, list, parseServedFloors(*flagServed), authority, persistence, overflow, options...)
+	if err != nil {
+		log.WithError(err).Fatal(name, " failed to start")
 	}
+
+	if *flagHealth != "" {
+		go services.ServeHealth(*flagHealth)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	log.WithField("signal", <-signals).Info(name, " received signal")
+
+	// A second signal skips the drain
+	go func() {
+		<-signals
+		log.Fatal(name, " killed while draining")
+	}()
+
+	if err := services.Shutdown(*flagFinishCarCalls, *flagDrainTimeout); err != nil {
+		log.WithError(err).Error(name, " drain failed")
+	}
+	remote.Shutdown(true)
+	stopTracing()
+
+	log.Info(name, " stopped")
 }
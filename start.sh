@@ -2,7 +2,7 @@
 
 for id in {0..15}; do
   val=$(printf "%02d" $id)
-  /go/bin/service --bind=127.0.0.1:90$val --id=$id &
+  /go/bin/service --bind=127.0.0.1:90$val --id=$id --health=127.0.0.1:91$val &
 done
 
 tail -f /dev/null